@@ -109,6 +109,9 @@ import (
 	chainparams "github.com/0glabs/0g-chain/app/params"
 	"github.com/0glabs/0g-chain/chaincfg"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
+	distributionprecompile "github.com/0glabs/0g-chain/precompiles/distribution"
+	govprecompile "github.com/0glabs/0g-chain/precompiles/gov"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
 
 	"github.com/0glabs/0g-chain/x/bep3"
 	bep3keeper "github.com/0glabs/0g-chain/x/bep3/keeper"
@@ -199,6 +202,7 @@ var (
 		issuancetypes.ModuleAccountName: {authtypes.Minter, authtypes.Burner},
 		bep3types.ModuleName:            {authtypes.Burner, authtypes.Minter},
 		minttypes.ModuleName:            {authtypes.Minter},
+		dasignerstypes.ModuleName:       nil,
 	}
 )
 
@@ -484,7 +488,7 @@ func NewApp(
 
 	evmBankKeeper := evmutilkeeper.NewEvmBankKeeper(app.evmutilKeeper, app.bankKeeper, app.accountKeeper)
 	// dasigners keeper
-	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper)
+	app.dasignersKeeper = dasignerskeeper.NewKeeper(keys[dasignerstypes.StoreKey], appCodec, app.stakingKeeper, app.bankKeeper, govAuthAddrStr)
 	// precopmiles
 	precompiles := make(map[common.Address]vm.PrecompiledContract)
 	daSignersPrecompile, err := dasignersprecompile.NewDASignersPrecompile(app.dasignersKeeper)
@@ -492,6 +496,22 @@ func NewApp(
 		panic("initialize precompile failed")
 	}
 	precompiles[daSignersPrecompile.Address()] = daSignersPrecompile
+	stakingPrecompile, err := stakingprecompile.NewStakingPrecompile(app.stakingKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[stakingPrecompile.Address()] = stakingPrecompile
+	distributionPrecompile, err := distributionprecompile.NewDistributionPrecompile(app.distrKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[distributionPrecompile.Address()] = distributionPrecompile
+	// the gov keeper is built below, the precompile reads it through the app
+	govPrecompile, err := govprecompile.NewGovPrecompile(&app.govKeeper)
+	if err != nil {
+		panic("initialize precompile failed")
+	}
+	precompiles[govPrecompile.Address()] = govPrecompile
 	// evm keeper
 	app.evmKeeper = evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey],
@@ -602,6 +622,7 @@ func NewApp(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
 			app.slashingKeeper.Hooks(),
+			app.dasignersKeeper.Hooks(),
 		))
 
 	// create gov keeper with router
@@ -671,7 +692,7 @@ func NewApp(
 		mint.NewAppModule(appCodec, app.mintKeeper, app.accountKeeper, nil, mintSubspace),
 		council.NewAppModule(app.CouncilKeeper),
 		ibcwasm.NewAppModule(app.ibcWasmClientKeeper),
-		dasigners.NewAppModule(app.dasignersKeeper, *app.stakingKeeper),
+		dasigners.NewAppModule(app.dasignersKeeper, *app.stakingKeeper, app.accountKeeper, app.bankKeeper),
 	)
 
 	// Warning: Some begin blockers must run before others. Ensure the dependencies are understood before modifying this list.
@@ -786,9 +807,9 @@ func NewApp(
 		counciltypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		packetforwardtypes.ModuleName,
+		dasignerstypes.ModuleName,
 		crisistypes.ModuleName, // runs the invariants at genesis, should run after other modules
 		ibcwasmtypes.ModuleName,
-		dasignerstypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -816,6 +837,11 @@ func NewApp(
 	// 	slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
 	// )
 	// app.sm.RegisterStoreDecoders()
+	// dasigners is simulated on its own until the modules above are wired in
+	app.sm = module.NewSimulationManager(
+		dasigners.NewAppModule(app.dasignersKeeper, *app.stakingKeeper, app.accountKeeper, app.bankKeeper),
+	)
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -10,6 +10,8 @@ import (
 
 const (
 	UpgradeName_Testnet = "v0.3.1"
+	// UpgradeName_DASigners migrates the dasigners params to consensus version 2
+	UpgradeName_DASigners = "v0.4.0"
 )
 
 // RegisterUpgradeHandlers registers the upgrade handlers for the app.
@@ -18,6 +20,10 @@ func (app App) RegisterUpgradeHandlers() {
 		UpgradeName_Testnet,
 		upgradeHandler(app, UpgradeName_Testnet),
 	)
+	app.upgradeKeeper.SetUpgradeHandler(
+		UpgradeName_DASigners,
+		migrationsUpgradeHandler(app, UpgradeName_DASigners),
+	)
 }
 
 // upgradeHandler returns an UpgradeHandler for the given upgrade parameters.
@@ -40,3 +46,20 @@ func upgradeHandler(
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	}
 }
+
+// migrationsUpgradeHandler returns an UpgradeHandler only running the module migrations.
+func migrationsUpgradeHandler(
+	app App,
+	name string,
+) upgradetypes.UpgradeHandler {
+	return func(
+		ctx sdk.Context,
+		plan upgradetypes.Plan,
+		fromVM module.VersionMap,
+	) (module.VersionMap, error) {
+		app.Logger().Info(fmt.Sprintf("running %s upgrade handler", name))
+
+		// run migrations for all modules and return new consensus version map
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	}
+}
@@ -30,13 +30,14 @@ import (
 	"github.com/0glabs/0g-chain/cmd/0gchaind/iavlviewer"
 	"github.com/0glabs/0g-chain/cmd/0gchaind/rocksdb"
 	"github.com/0glabs/0g-chain/cmd/opendb"
+	"github.com/0glabs/0g-chain/crypto/bls"
 	"github.com/0glabs/0g-chain/crypto/vrf"
 )
 
 func customKeyringOptions() keyring.Option {
 	return func(options *keyring.Options) {
-		options.SupportedAlgos = append(hd.SupportedAlgorithms, vrf.VrfAlgo)
-		options.SupportedAlgosLedger = append(hd.SupportedAlgorithmsLedger, vrf.VrfAlgo)
+		options.SupportedAlgos = append(hd.SupportedAlgorithms, vrf.VrfAlgo, bls.Bn254Algo)
+		options.SupportedAlgosLedger = append(hd.SupportedAlgorithmsLedger, vrf.VrfAlgo, bls.Bn254Algo)
 	}
 }
 
@@ -0,0 +1,58 @@
+package bls
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var (
+	// SupportedAlgorithms defines the list of signing algorithms supported by BlsOption:
+	//  - bn254 (DA signers)
+	SupportedAlgorithms = keyring.SigningAlgoList{Bn254Algo}
+	// SupportedAlgorithmsLedger defines the list of signing algorithms supported by BlsOption for the Ledger device:
+	//  - bn254 (DA signers)
+	SupportedAlgorithmsLedger = keyring.SigningAlgoList{Bn254Algo}
+)
+
+func BlsOption() keyring.Option {
+	return func(options *keyring.Options) {
+		options.SupportedAlgos = SupportedAlgorithms
+		options.SupportedAlgosLedger = SupportedAlgorithmsLedger
+	}
+}
+
+const (
+	Bn254Type = hd.PubKeyType(KeyType)
+)
+
+var (
+	_         keyring.SignatureAlgo = Bn254Algo
+	Bn254Algo                       = bn254Algo{}
+)
+
+type bn254Algo struct{}
+
+func (s bn254Algo) Name() hd.PubKeyType {
+	return Bn254Type
+}
+
+// Derive derives the key material from the mnemonic along the BIP44 path, so
+// that bn254 keys can be recovered like any other keyring key.
+func (s bn254Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+// Generate reduces the derived key material into a bn254 scalar.
+func (s bn254Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		var sk fr.Element
+		sk.SetBytes(bz)
+		key := sk.Bytes()
+
+		return &PrivKey{
+			Key: key[:],
+		}
+	}
+}
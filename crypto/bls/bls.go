@@ -0,0 +1,252 @@
+package bls
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	tmcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+)
+
+const (
+	// PrivKeySize defines the size of the PrivKey bytes
+	PrivKeySize = 32
+	// PubKeySize defines the size of the PubKey bytes, a G1 point followed by a G2 point
+	PubKeySize = bn254util.G1PointSize + bn254util.G2PointSize
+	// SignatureSize defines the size of a signature, a G1 point
+	SignatureSize = bn254util.G1PointSize
+	// KeyType is the string constant for the bn254 algorithm
+	KeyType = "bn254"
+)
+
+// Amino encoding names
+const (
+	// PrivKeyName defines the amino encoding name for the bn254 private key
+	PrivKeyName = "bls/PrivKey"
+	// PubKeyName defines the amino encoding name for the bn254 public key
+	PubKeyName = "bls/PubKey"
+)
+
+// ----------------------------------------------------------------------------
+// bn254 Private Key
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+// GenerateKey generates a new random private key. It returns an error upon
+// failure.
+func GenerateKey() (*PrivKey, error) {
+	var sk fr.Element
+	if _, err := sk.SetRandom(); err != nil {
+		return nil, err
+	}
+	if sk.IsZero() {
+		return GenerateKey()
+	}
+	bz := sk.Bytes()
+
+	return &PrivKey{
+		Key: bz[:],
+	}, nil
+}
+
+// PrivKeyFromBytes returns the private key of the given big-endian scalar. It
+// returns an error if the scalar is zero or not lower than the curve order.
+func PrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	sk := new(big.Int).SetBytes(bz)
+	if sk.Sign() <= 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return nil, fmt.Errorf("bn254 private key out of range")
+	}
+
+	return &PrivKey{
+		Key: sk.FillBytes(make([]byte, PrivKeySize)),
+	}, nil
+}
+
+// Scalar returns the private key as an integer.
+func (privKey PrivKey) Scalar() *big.Int {
+	return new(big.Int).SetBytes(privKey.Key)
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey PrivKey) Bytes() []byte {
+	bz := make([]byte, len(privKey.Key))
+	copy(bz, privKey.Key)
+
+	return bz
+}
+
+// PubKey returns the private key's public key, holding both the G1 and the G2
+// points.
+func (privKey PrivKey) PubKey() cryptotypes.PubKey {
+	sk := privKey.Scalar()
+	pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)
+	pkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+
+	key := make([]byte, 0, PubKeySize)
+	key = append(key, bn254util.SerializeG1(pkG1)...)
+	key = append(key, bn254util.SerializeG2(pkG2)...)
+
+	return &PubKey{
+		Key: key,
+	}
+}
+
+// Equals returns true if two private keys are equal and false otherwise.
+func (privKey PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+// Type returns bn254
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign hashes the message with keccak256, maps the digest to G1 and returns
+// the serialized signature of that point. This matches the hashes used for
+// dasigners registrations, which are computed the same way over their preimage.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	var digest [32]byte
+	copy(digest[:], crypto.Keccak256(msg))
+
+	return privKey.SignHash(bn254util.MapToCurve(digest)), nil
+}
+
+// SignHash returns the serialized signature of a message already mapped to G1.
+func (privKey PrivKey) SignHash(hash *bn254.G1Affine) []byte {
+	return bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, privKey.Scalar()))
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size, expected %d got %d", PrivKeySize, len(bz))
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// ----------------------------------------------------------------------------
+// bn254 Public Key
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// Address returns the address of the public key.
+func (pubKey PubKey) Address() tmcrypto.Address {
+	return tmcrypto.AddressHash(pubKey.Key)
+}
+
+// Bytes returns the raw bytes of the public key.
+func (pubKey PubKey) Bytes() []byte {
+	bz := make([]byte, len(pubKey.Key))
+	copy(bz, pubKey.Key)
+
+	return bz
+}
+
+// G1 returns the serialized G1 public key.
+func (pubKey PubKey) G1() []byte {
+	if len(pubKey.Key) != PubKeySize {
+		return nil
+	}
+	return pubKey.Bytes()[:bn254util.G1PointSize]
+}
+
+// G2 returns the serialized G2 public key.
+func (pubKey PubKey) G2() []byte {
+	if len(pubKey.Key) != PubKeySize {
+		return nil
+	}
+	return pubKey.Bytes()[bn254util.G1PointSize:]
+}
+
+// String implements the fmt.Stringer interface.
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("bn254{%X}", pubKey.Key)
+}
+
+// Type returns bn254
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+// Equals returns true if the pubkey type is the same and their bytes are deeply equal.
+func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature produced by PrivKey.Sign over msg
+// against the G2 public key.
+func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(pubKey.Key) != PubKeySize || len(sig) != SignatureSize {
+		return false
+	}
+	var digest [32]byte
+	copy(digest[:], crypto.Keccak256(msg))
+
+	ok, err := bn254util.VerifySig(bn254util.DeserializeG1(sig), bn254util.DeserializeG2(pubKey.G2()), digest)
+	if err != nil {
+		return false
+	}
+	return ok
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "invalid pubkey size, expected %d, got %d", PubKeySize, len(bz))
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
@@ -0,0 +1,155 @@
+package bls
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+)
+
+func TestPrivKey(t *testing.T) {
+	// validate type and equality
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	require.Implements(t, (*cryptotypes.PrivKey)(nil), privKey)
+	require.Len(t, privKey.Bytes(), PrivKeySize)
+
+	// validate inequality
+	privKey2, err := GenerateKey()
+	require.NoError(t, err)
+	require.False(t, privKey.Equals(privKey2))
+
+	// validate range checks
+	_, err = PrivKeyFromBytes([]byte{0})
+	require.Error(t, err)
+	_, err = PrivKeyFromBytes(bn254util.FR_MODULUS.Bytes())
+	require.Error(t, err)
+	privKey3, err := PrivKeyFromBytes([]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), privKey3.Scalar().Int64())
+	require.Len(t, privKey3.Bytes(), PrivKeySize)
+}
+
+func TestPrivKey_PubKey(t *testing.T) {
+	privKey, err := PrivKeyFromBytes([]byte{1})
+	require.NoError(t, err)
+
+	// the public key of scalar one is made of the generators
+	pubKey := privKey.PubKey().(*PubKey)
+	require.Implements(t, (*cryptotypes.PubKey)(nil), pubKey)
+	require.Len(t, pubKey.Bytes(), PubKeySize)
+	require.Equal(t, bn254util.SerializeG1(bn254util.GetG1Generator()), pubKey.G1())
+	require.Equal(t, bn254util.SerializeG2(bn254util.GetG2Generator()), pubKey.G2())
+
+	// validate inequality
+	privKey2, err := GenerateKey()
+	require.NoError(t, err)
+	require.False(t, pubKey.Equals(privKey2.PubKey()))
+}
+
+func TestSign(t *testing.T) {
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	msg := []byte("0G_BN254_Pubkey_Registration")
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature([]byte("other"), sig))
+
+	// signing a message equals signing its keccak256 digest mapped to G1
+	var digest [32]byte
+	copy(digest[:], crypto.Keccak256(msg))
+	require.Equal(t, sig, privKey.SignHash(bn254util.MapToCurve(digest)))
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey, err := GenerateKey()
+	require.NoError(t, err)
+
+	pubKey := privKey.PubKey().(*PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"bn254 private key",
+			privKey,
+			&PrivKey{},
+			append([]byte{32}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"bn254 public key",
+			pubKey,
+			&PubKey{},
+			append([]byte{192, 1}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &PrivKey{})
+	cdc := codec.NewProtoCodec(registry)
+	kr := keyring.NewInMemory(cdc, BlsOption())
+
+	record, mnemonic, err := kr.NewMnemonic("signer", keyring.English, types.FullFundraiserPath, keyring.DefaultBIP39Passphrase, Bn254Algo)
+	require.NoError(t, err)
+	pk, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, KeyType, pk.Type())
+
+	// the key can be recovered from its mnemonic
+	recovered, err := keyring.NewInMemory(cdc, BlsOption()).NewAccount("recovered", mnemonic, keyring.DefaultBIP39Passphrase, types.FullFundraiserPath, Bn254Algo)
+	require.NoError(t, err)
+	pk2, err := recovered.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pk.Equals(pk2))
+
+	msg := []byte("message")
+	sig, _, err := kr.Sign("signer", msg)
+	require.NoError(t, err)
+	require.True(t, pk.VerifySignature(msg, sig))
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// SyncBalances applies the balance changes made by a method in the sdk context to the EVM state. The EVM state caches
+// the balances of the accounts it touched and writes them back when it commits, so without the sync the coins moved
+// by the method would be minted or burnt again. The accounts are the ones of the bank events emitted in cacheCtx, their
+// balances are compared between ctx and cacheCtx as the EVM keeper reads them. The balance changes are journaled by the
+// statedb and the sdk writes by the JournaledStateDB, so both are reverted if an enclosing call frame reverts.
+func SyncBalances(ctx sdk.Context, cacheCtx sdk.Context, stateDB *statedb.StateDB) error {
+	keeper := stateDB.Keeper()
+	accounts := balanceChangedAccounts(cacheCtx.EventManager().Events())
+	// the deltas are all read and checked before the EVM state is changed
+	deltas := make([]*big.Int, len(accounts))
+	for i, addr := range accounts {
+		deltas[i] = new(big.Int).Sub(accountBalance(cacheCtx, keeper, addr), accountBalance(ctx, keeper, addr))
+		if deltas[i].Sign() < 0 && stateDB.GetBalance(addr).Cmp(new(big.Int).Neg(deltas[i])) < 0 {
+			return fmt.Errorf(ErrInsufficientBalance, addr)
+		}
+	}
+	for i, addr := range accounts {
+		switch deltas[i].Sign() {
+		case 1:
+			stateDB.AddBalance(addr, deltas[i])
+		case -1:
+			stateDB.SubBalance(addr, new(big.Int).Neg(deltas[i]))
+		}
+	}
+	return nil
+}
+
+// balanceChangedAccounts returns the spenders and receivers of the bank events in the order they are emitted
+func balanceChangedAccounts(events sdk.Events) []common.Address {
+	accounts := make([]common.Address, 0)
+	seen := make(map[common.Address]struct{})
+	for _, event := range events {
+		var key string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			key = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != key {
+				continue
+			}
+			accAddr, err := sdk.AccAddressFromBech32(attr.Value)
+			if err != nil {
+				continue
+			}
+			addr := common.BytesToAddress(accAddr)
+			if _, ok := seen[addr]; !ok {
+				seen[addr] = struct{}{}
+				accounts = append(accounts, addr)
+			}
+		}
+	}
+	return accounts
+}
+
+func accountBalance(ctx sdk.Context, keeper statedb.Keeper, addr common.Address) *big.Int {
+	account := keeper.GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
@@ -3,4 +3,6 @@ package common
 const (
 	ErrGetStateDB          = "get EVM StateDB failed"
 	ErrInvalidNumberOfArgs = "invalid number of arguments; expected %d; got: %d"
+	ErrNonPayable          = "method %s is not payable"
+	ErrInsufficientBalance = "insufficient EVM balance of %s"
 )
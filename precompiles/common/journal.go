@@ -0,0 +1,199 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// journalEntry holds the value a store key had before a precompile call wrote it, nil if the key was not set
+type journalEntry struct {
+	storeKey storetypes.StoreKey
+	key      []byte
+	value    []byte
+}
+
+type journalRevision struct {
+	id    int
+	index int
+}
+
+// JournaledStateDB extends the EVM state with a journal of the sdk writes made by the precompiles. The statedb of this
+// ethermint version does not take journal entries from outside, so the EVM is switched to the wrapper by the first
+// precompile call and the sdk writes are reverted together with the EVM state whenever a call frame reverts. Only the
+// snapshots and the context are wrapped, the EVM state is still committed by the statedb.
+type JournaledStateDB struct {
+	*statedb.StateDB
+	entries   []journalEntry
+	revisions []journalRevision
+}
+
+var _ vm.StateDB = &JournaledStateDB{}
+
+// GetJournaledStateDB returns the journaled state of the EVM, the EVM is switched to it on the first call
+func GetJournaledStateDB(evm *vm.EVM) (*JournaledStateDB, error) {
+	switch stateDB := evm.StateDB.(type) {
+	case *JournaledStateDB:
+		return stateDB, nil
+	case *statedb.StateDB:
+		journaled := &JournaledStateDB{StateDB: stateDB}
+		evm.StateDB = journaled
+		return journaled, nil
+	}
+	return nil, fmt.Errorf(ErrGetStateDB)
+}
+
+// GetContext returns the context of the EVM state whose store writes are journaled
+func (s *JournaledStateDB) GetContext() sdk.Context {
+	ctx := s.StateDB.GetContext()
+	return ctx.WithMultiStore(journalMultiStore{MultiStore: ctx.MultiStore(), journal: s})
+}
+
+// Snapshot implements vm.StateDB.
+func (s *JournaledStateDB) Snapshot() int {
+	id := s.StateDB.Snapshot()
+	s.revisions = append(s.revisions, journalRevision{id: id, index: len(s.entries)})
+	return id
+}
+
+// RevertToSnapshot implements vm.StateDB.
+func (s *JournaledStateDB) RevertToSnapshot(revid int) {
+	s.StateDB.RevertToSnapshot(revid)
+	// the snapshots taken before the EVM was switched to the journal precede all the journaled writes
+	idx := sort.Search(len(s.revisions), func(i int) bool {
+		return s.revisions[i].id >= revid
+	})
+	index := 0
+	if idx < len(s.revisions) && s.revisions[idx].id == revid {
+		index = s.revisions[idx].index
+	}
+	s.revisions = s.revisions[:idx]
+
+	ms := s.StateDB.GetContext().MultiStore()
+	for i := len(s.entries) - 1; i >= index; i-- {
+		entry := s.entries[i]
+		store := ms.GetKVStore(entry.storeKey)
+		if entry.value == nil {
+			store.Delete(entry.key)
+		} else {
+			store.Set(entry.key, entry.value)
+		}
+	}
+	s.entries = s.entries[:index]
+}
+
+func (s *JournaledStateDB) record(storeKey storetypes.StoreKey, store storetypes.KVStore, key []byte) {
+	entry := journalEntry{
+		storeKey: storeKey,
+		key:      append([]byte(nil), key...),
+	}
+	if value := store.Get(key); value != nil {
+		entry.value = append([]byte(nil), value...)
+	}
+	s.entries = append(s.entries, entry)
+}
+
+// journalMultiStore writes through to the stores of the EVM state and journals the previous value of every key written
+type journalMultiStore struct {
+	storetypes.MultiStore
+	journal *JournaledStateDB
+}
+
+func (ms journalMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms journalMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return journalStore{KVStore: ms.MultiStore.GetKVStore(key), storeKey: key, journal: ms.journal}
+}
+
+func (ms journalMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+func (ms journalMultiStore) CacheWrap() storetypes.CacheWrap {
+	return newCacheMultiStore(ms)
+}
+
+func (ms journalMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return newCacheMultiStore(ms)
+}
+
+type journalStore struct {
+	storetypes.KVStore
+	storeKey storetypes.StoreKey
+	journal  *JournaledStateDB
+}
+
+func (s journalStore) Set(key, value []byte) {
+	s.journal.record(s.storeKey, s.KVStore, key)
+	s.KVStore.Set(key, value)
+}
+
+func (s journalStore) Delete(key []byte) {
+	s.journal.record(s.storeKey, s.KVStore, key)
+	s.KVStore.Delete(key)
+}
+
+func (s journalStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s journalStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// cacheMultiStore caches the stores of its parent as they are accessed, so that the writes flushed to a journaled
+// multistore go through its journal
+type cacheMultiStore struct {
+	storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+	// keys keeps the access order so that the writes are flushed deterministically
+	keys []storetypes.StoreKey
+}
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		MultiStore: parent,
+		stores:     make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+func (ms *cacheMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *cacheMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if store, ok := ms.stores[key]; ok {
+		return store
+	}
+	store := cachekv.NewStore(ms.MultiStore.GetKVStore(key))
+	ms.stores[key] = store
+	ms.keys = append(ms.keys, key)
+	return store
+}
+
+func (ms *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(ms)
+}
+
+func (ms *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return newCacheMultiStore(ms)
+}
+
+func (ms *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return newCacheMultiStore(ms)
+}
+
+// Write flushes the cached writes to the parent stores
+func (ms *cacheMultiStore) Write() {
+	for _, key := range ms.keys {
+		ms.stores[key].Write()
+	}
+}
@@ -0,0 +1,136 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const RequiredGasMax uint64 = 1000_000_000
+
+// KVGasConfig disables the store gas of the sdk context, it is used by the precompiles without a metered gas schedule
+var KVGasConfig storetypes.GasConfig = storetypes.GasConfig{
+	HasCost:          0,
+	DeleteCost:       0,
+	ReadCostFlat:     0,
+	ReadCostPerByte:  0,
+	WriteCostFlat:    0,
+	WriteCostPerByte: 0,
+	IterNextCostFlat: 0,
+}
+
+// KVGasConfigProvider returns the store gas config charged while running a method
+type KVGasConfigProvider func(ctx sdk.Context) storetypes.GasConfig
+
+// Executor runs the called method of a precompile against the sdk context of the EVM state
+type Executor func(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error)
+
+// PrecompileBase implements the parts of vm.PrecompiledContract shared by the stateful precompiles. The methods
+// are classified by the state mutability declared in the ABI: view and pure methods are queries, any other method
+// is a transaction.
+type PrecompileBase struct {
+	ABI              abi.ABI
+	RequiredGasBasic map[string]uint64
+	// KVGasConfig meters the store access of the methods if set, otherwise only the gas consumed explicitly is charged
+	KVGasConfig KVGasConfigProvider
+}
+
+func NewPrecompileBase(abiJSON string, requiredGasBasic map[string]uint64) (PrecompileBase, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return PrecompileBase{}, err
+	}
+	return PrecompileBase{
+		ABI:              parsed,
+		RequiredGasBasic: requiredGasBasic,
+	}, nil
+}
+
+// IsTransaction reports whether the method changes state
+func IsTransaction(method *abi.Method) bool {
+	return !method.IsConstant()
+}
+
+// RequiredGas implements vm.PrecompiledContract.
+func (p PrecompileBase) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return RequiredGasMax
+	}
+	method, err := p.ABI.MethodById(input[:4])
+	if err != nil {
+		return RequiredGasMax
+	}
+	if gas, ok := p.RequiredGasBasic[method.Name]; ok {
+		return gas
+	}
+	return RequiredGasMax
+}
+
+// Execute parses the call, rejects transactions in a read only context (static and delegate calls) and value sent to
+// non-payable methods, then runs the method and charges the gas it consumed in the sdk context. The method runs under
+// a gas meter limited to the gas left in the contract, so it is aborted as soon as it runs out of gas. The sdk writes
+// of the method are made in a cache context, they are discarded unless the method succeeds and its gas is paid, the
+// balances they change are synced into the EVM state. The written sdk state is journaled, an enclosing call frame
+// reverting later reverts it too.
+func (p PrecompileBase) Execute(evm *vm.EVM, contract *vm.Contract, readonly bool, execute Executor) (bz []byte, err error) {
+	// parse input
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+	method, err := p.ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+	if readonly && IsTransaction(method) {
+		return nil, vm.ErrWriteProtection
+	}
+	if value := contract.Value(); value != nil && value.Sign() > 0 && !method.IsPayable() {
+		return nil, fmt.Errorf(ErrNonPayable, method.Name)
+	}
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+	// get state db and context, the sdk writes are journaled so that they are reverted with the call frames
+	journal, err := GetJournaledStateDB(evm)
+	if err != nil {
+		return nil, err
+	}
+	stateDB := journal.StateDB
+	ctx := journal.GetContext()
+	// reset gas config
+	kvGasConfig := KVGasConfig
+	if p.KVGasConfig != nil {
+		kvGasConfig = p.KVGasConfig(ctx.WithKVGasConfig(KVGasConfig))
+	}
+	gasMeter := storetypes.NewGasMeter(contract.Gas)
+	ctx = ctx.WithKVGasConfig(kvGasConfig).WithGasMeter(gasMeter)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			bz, err = nil, vm.ErrOutOfGas
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	bz, err = execute(cacheCtx, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SyncBalances(ctx, cacheCtx, stateDB); err != nil {
+		return nil, err
+	}
+	if !contract.UseGas(gasMeter.GasConsumed()) {
+		return nil, vm.ErrOutOfGas
+	}
+	writeCache()
+	return bz, nil
+}
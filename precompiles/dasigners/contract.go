@@ -30,7 +30,7 @@ var (
 
 // DASignersMetaData contains all meta data concerning the DASigners contract.
 var DASignersMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"EpochRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"name\":\"NewSigner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"RewardsClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousSigner\",\"type\":\"address\"}],\"name\":\"SignerAuthorized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"exitEpoch\",\"type\":\"uint256\"}],\"name\":\"SignerDeregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"indexed\":false,\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveEpoch\",\"type\":\"uint256\"}],\"name\":\"SignerKeyRotated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"}],\"name\":\"SocketUpdated\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_signer\",\"type\":\"address\"}],\"name\":\"authorizeSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"claimRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deregisterSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"}],\"name\":\"getAggPkG1\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"aggPkG1\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"getEpochParams\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"tokensPerVote\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxVotesPerSigner\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxQuorums\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"epochBlocks\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"encodedSlices\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"exitCooldownEpochs\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"slashFractionBps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"historyRetentionEpochs\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardFeeShareBps\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minQuorumSigners\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"maxSignerShareBps\",\"type\":\"uint256\"}],\"internalType\":\"structIDASigners.Params\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"}],\"name\":\"getQuorum\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"uint32\",\"name\":\"_rowIndex\",\"type\":\"uint32\"}],\"name\":\"getQuorumRow\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_account\",\"type\":\"address[]\"}],\"name\":\"getSigner\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isSigner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"pendingRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"quorumCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerNextEpoch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"},{\"internalType\":\"address\",\"name\":\"_operator\",\"type\":\"address\"}],\"name\":\"registerOperatedSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"socket\",\"type\":\"string\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"pkG2\",\"type\":\"tuple\"}],\"internalType\":\"structIDASigners.SignerDetail\",\"name\":\"_signer\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"registerSigner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"}],\"name\":\"registeredEpoch\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_pkG1\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256[2]\",\"name\":\"X\",\"type\":\"uint256[2]\"},{\"internalType\":\"uint256[2]\",\"name\":\"Y\",\"type\":\"uint256[2]\"}],\"internalType\":\"structBN254.G2Point\",\"name\":\"_pkG2\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_signature\",\"type\":\"tuple\"}],\"name\":\"rotateSignerKey\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"signerOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_socket\",\"type\":\"string\"}],\"name\":\"updateSocket\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_epoch\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_quorumId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_quorumBitmap\",\"type\":\"bytes\"},{\"internalType\":\"bytes32\",\"name\":\"_msgHash\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"X\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"Y\",\"type\":\"uint256\"}],\"internalType\":\"structBN254.G1Point\",\"name\":\"_aggSig\",\"type\":\"tuple\"}],\"name\":\"verifyAggSig\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"valid\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"hit\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 }
 
 // DASignersABI is the input ABI used to generate the binding from.
@@ -260,6 +260,37 @@ func (_DASigners *DASignersCallerSession) GetAggPkG1(_epoch *big.Int, _quorumId
 	return _DASigners.Contract.GetAggPkG1(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap)
 }
 
+// GetEpochParams is a free data retrieval call binding the contract method 0x017b12a8.
+//
+// Solidity: function getEpochParams(uint256 _epoch) view returns((uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256))
+func (_DASigners *DASignersCaller) GetEpochParams(opts *bind.CallOpts, _epoch *big.Int) (IDASignersParams, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "getEpochParams", _epoch)
+
+	if err != nil {
+		return *new(IDASignersParams), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IDASignersParams)).(*IDASignersParams)
+
+	return out0, err
+
+}
+
+// GetEpochParams is a free data retrieval call binding the contract method 0x017b12a8.
+//
+// Solidity: function getEpochParams(uint256 _epoch) view returns((uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256))
+func (_DASigners *DASignersSession) GetEpochParams(_epoch *big.Int) (IDASignersParams, error) {
+	return _DASigners.Contract.GetEpochParams(&_DASigners.CallOpts, _epoch)
+}
+
+// GetEpochParams is a free data retrieval call binding the contract method 0x017b12a8.
+//
+// Solidity: function getEpochParams(uint256 _epoch) view returns((uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256))
+func (_DASigners *DASignersCallerSession) GetEpochParams(_epoch *big.Int) (IDASignersParams, error) {
+	return _DASigners.Contract.GetEpochParams(&_DASigners.CallOpts, _epoch)
+}
+
 // GetQuorum is a free data retrieval call binding the contract method 0x6ab6f654.
 //
 // Solidity: function getQuorum(uint256 _epoch, uint256 _quorumId) view returns(address[])
@@ -384,6 +415,37 @@ func (_DASigners *DASignersCallerSession) IsSigner(_account common.Address) (boo
 	return _DASigners.Contract.IsSigner(&_DASigners.CallOpts, _account)
 }
 
+// PendingRewards is a free data retrieval call binding the contract method 0x31d7a262.
+//
+// Solidity: function pendingRewards(address _account) view returns(uint256)
+func (_DASigners *DASignersCaller) PendingRewards(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "pendingRewards", _account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PendingRewards is a free data retrieval call binding the contract method 0x31d7a262.
+//
+// Solidity: function pendingRewards(address _account) view returns(uint256)
+func (_DASigners *DASignersSession) PendingRewards(_account common.Address) (*big.Int, error) {
+	return _DASigners.Contract.PendingRewards(&_DASigners.CallOpts, _account)
+}
+
+// PendingRewards is a free data retrieval call binding the contract method 0x31d7a262.
+//
+// Solidity: function pendingRewards(address _account) view returns(uint256)
+func (_DASigners *DASignersCallerSession) PendingRewards(_account common.Address) (*big.Int, error) {
+	return _DASigners.Contract.PendingRewards(&_DASigners.CallOpts, _account)
+}
+
 // QuorumCount is a free data retrieval call binding the contract method 0x5ecba503.
 //
 // Solidity: function quorumCount(uint256 _epoch) view returns(uint256)
@@ -446,6 +508,150 @@ func (_DASigners *DASignersCallerSession) RegisteredEpoch(_account common.Addres
 	return _DASigners.Contract.RegisteredEpoch(&_DASigners.CallOpts, _account, _epoch)
 }
 
+// SignerOperator is a free data retrieval call binding the contract method 0x18aa9763.
+//
+// Solidity: function signerOperator(address _account) view returns(address)
+func (_DASigners *DASignersCaller) SignerOperator(opts *bind.CallOpts, _account common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "signerOperator", _account)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SignerOperator is a free data retrieval call binding the contract method 0x18aa9763.
+//
+// Solidity: function signerOperator(address _account) view returns(address)
+func (_DASigners *DASignersSession) SignerOperator(_account common.Address) (common.Address, error) {
+	return _DASigners.Contract.SignerOperator(&_DASigners.CallOpts, _account)
+}
+
+// SignerOperator is a free data retrieval call binding the contract method 0x18aa9763.
+//
+// Solidity: function signerOperator(address _account) view returns(address)
+func (_DASigners *DASignersCallerSession) SignerOperator(_account common.Address) (common.Address, error) {
+	return _DASigners.Contract.SignerOperator(&_DASigners.CallOpts, _account)
+}
+
+// VerifyAggSig is a free data retrieval call binding the contract method 0xfdada955.
+//
+// Solidity: function verifyAggSig(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _msgHash, (uint256,uint256) _aggSig) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCaller) VerifyAggSig(opts *bind.CallOpts, _epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _msgHash [32]byte, _aggSig BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	var out []interface{}
+	err := _DASigners.contract.Call(opts, &out, "verifyAggSig", _epoch, _quorumId, _quorumBitmap, _msgHash, _aggSig)
+
+	outstruct := new(struct {
+		Valid bool
+		Total *big.Int
+		Hit   *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Valid = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.Hit = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// VerifyAggSig is a free data retrieval call binding the contract method 0xfdada955.
+//
+// Solidity: function verifyAggSig(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _msgHash, (uint256,uint256) _aggSig) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersSession) VerifyAggSig(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _msgHash [32]byte, _aggSig BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyAggSig(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _msgHash, _aggSig)
+}
+
+// VerifyAggSig is a free data retrieval call binding the contract method 0xfdada955.
+//
+// Solidity: function verifyAggSig(uint256 _epoch, uint256 _quorumId, bytes _quorumBitmap, bytes32 _msgHash, (uint256,uint256) _aggSig) view returns(bool valid, uint256 total, uint256 hit)
+func (_DASigners *DASignersCallerSession) VerifyAggSig(_epoch *big.Int, _quorumId *big.Int, _quorumBitmap []byte, _msgHash [32]byte, _aggSig BN254G1Point) (struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+}, error) {
+	return _DASigners.Contract.VerifyAggSig(&_DASigners.CallOpts, _epoch, _quorumId, _quorumBitmap, _msgHash, _aggSig)
+}
+
+// AuthorizeSigner is a paid mutator transaction binding the contract method 0xbfe51c10.
+//
+// Solidity: function authorizeSigner(address _signer) returns()
+func (_DASigners *DASignersTransactor) AuthorizeSigner(opts *bind.TransactOpts, _signer common.Address) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "authorizeSigner", _signer)
+}
+
+// AuthorizeSigner is a paid mutator transaction binding the contract method 0xbfe51c10.
+//
+// Solidity: function authorizeSigner(address _signer) returns()
+func (_DASigners *DASignersSession) AuthorizeSigner(_signer common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.AuthorizeSigner(&_DASigners.TransactOpts, _signer)
+}
+
+// AuthorizeSigner is a paid mutator transaction binding the contract method 0xbfe51c10.
+//
+// Solidity: function authorizeSigner(address _signer) returns()
+func (_DASigners *DASignersTransactorSession) AuthorizeSigner(_signer common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.AuthorizeSigner(&_DASigners.TransactOpts, _signer)
+}
+
+// ClaimRewards is a paid mutator transaction binding the contract method 0x372500ab.
+//
+// Solidity: function claimRewards() returns(uint256)
+func (_DASigners *DASignersTransactor) ClaimRewards(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "claimRewards")
+}
+
+// ClaimRewards is a paid mutator transaction binding the contract method 0x372500ab.
+//
+// Solidity: function claimRewards() returns(uint256)
+func (_DASigners *DASignersSession) ClaimRewards() (*types.Transaction, error) {
+	return _DASigners.Contract.ClaimRewards(&_DASigners.TransactOpts)
+}
+
+// ClaimRewards is a paid mutator transaction binding the contract method 0x372500ab.
+//
+// Solidity: function claimRewards() returns(uint256)
+func (_DASigners *DASignersTransactorSession) ClaimRewards() (*types.Transaction, error) {
+	return _DASigners.Contract.ClaimRewards(&_DASigners.TransactOpts)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactor) DeregisterSigner(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "deregisterSigner")
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
+// DeregisterSigner is a paid mutator transaction binding the contract method 0xa544bb9f.
+//
+// Solidity: function deregisterSigner() returns()
+func (_DASigners *DASignersTransactorSession) DeregisterSigner() (*types.Transaction, error) {
+	return _DASigners.Contract.DeregisterSigner(&_DASigners.TransactOpts)
+}
+
 // RegisterNextEpoch is a paid mutator transaction binding the contract method 0x56a32372.
 //
 // Solidity: function registerNextEpoch((uint256,uint256) _signature) returns()
@@ -467,6 +673,27 @@ func (_DASigners *DASignersTransactorSession) RegisterNextEpoch(_signature BN254
 	return _DASigners.Contract.RegisterNextEpoch(&_DASigners.TransactOpts, _signature)
 }
 
+// RegisterOperatedSigner is a paid mutator transaction binding the contract method 0xf420fc2c.
+//
+// Solidity: function registerOperatedSigner((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint256,uint256) _signature, address _operator) returns()
+func (_DASigners *DASignersTransactor) RegisterOperatedSigner(opts *bind.TransactOpts, _signer IDASignersSignerDetail, _signature BN254G1Point, _operator common.Address) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "registerOperatedSigner", _signer, _signature, _operator)
+}
+
+// RegisterOperatedSigner is a paid mutator transaction binding the contract method 0xf420fc2c.
+//
+// Solidity: function registerOperatedSigner((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint256,uint256) _signature, address _operator) returns()
+func (_DASigners *DASignersSession) RegisterOperatedSigner(_signer IDASignersSignerDetail, _signature BN254G1Point, _operator common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterOperatedSigner(&_DASigners.TransactOpts, _signer, _signature, _operator)
+}
+
+// RegisterOperatedSigner is a paid mutator transaction binding the contract method 0xf420fc2c.
+//
+// Solidity: function registerOperatedSigner((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint256,uint256) _signature, address _operator) returns()
+func (_DASigners *DASignersTransactorSession) RegisterOperatedSigner(_signer IDASignersSignerDetail, _signature BN254G1Point, _operator common.Address) (*types.Transaction, error) {
+	return _DASigners.Contract.RegisterOperatedSigner(&_DASigners.TransactOpts, _signer, _signature, _operator)
+}
+
 // RegisterSigner is a paid mutator transaction binding the contract method 0x7ca4dd5e.
 //
 // Solidity: function registerSigner((address,string,(uint256,uint256),(uint256[2],uint256[2])) _signer, (uint256,uint256) _signature) returns()
@@ -488,6 +715,27 @@ func (_DASigners *DASignersTransactorSession) RegisterSigner(_signer IDASignersS
 	return _DASigners.Contract.RegisterSigner(&_DASigners.TransactOpts, _signer, _signature)
 }
 
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactor) RotateSignerKey(opts *bind.TransactOpts, _pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.contract.Transact(opts, "rotateSignerKey", _pkG1, _pkG2, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _signature)
+}
+
+// RotateSignerKey is a paid mutator transaction binding the contract method 0xdef9f50c.
+//
+// Solidity: function rotateSignerKey((uint256,uint256) _pkG1, (uint256[2],uint256[2]) _pkG2, (uint256,uint256) _signature) returns()
+func (_DASigners *DASignersTransactorSession) RotateSignerKey(_pkG1 BN254G1Point, _pkG2 BN254G2Point, _signature BN254G1Point) (*types.Transaction, error) {
+	return _DASigners.Contract.RotateSignerKey(&_DASigners.TransactOpts, _pkG1, _pkG2, _signature)
+}
+
 // UpdateSocket is a paid mutator transaction binding the contract method 0x0cf4b767.
 //
 // Solidity: function updateSocket(string _socket) returns()
@@ -509,6 +757,151 @@ func (_DASigners *DASignersTransactorSession) UpdateSocket(_socket string) (*typ
 	return _DASigners.Contract.UpdateSocket(&_DASigners.TransactOpts, _socket)
 }
 
+// DASignersEpochRegisteredIterator is returned from FilterEpochRegistered and is used to iterate over the raw logs and unpacked data for EpochRegistered events raised by the DASigners contract.
+type DASignersEpochRegisteredIterator struct {
+	Event *DASignersEpochRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersEpochRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersEpochRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersEpochRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersEpochRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersEpochRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersEpochRegistered represents a EpochRegistered event raised by the DASigners contract.
+type DASignersEpochRegistered struct {
+	Signer common.Address
+	Epoch  *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochRegistered is a free log retrieval operation binding the contract event 0x7445ea0496d2dd94d95c8760ebb7cbf2711d11384c1c5cf2b3b4657c5aa027d9.
+//
+// Solidity: event EpochRegistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) FilterEpochRegistered(opts *bind.FilterOpts, signer []common.Address) (*DASignersEpochRegisteredIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "EpochRegistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersEpochRegisteredIterator{contract: _DASigners.contract, event: "EpochRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochRegistered is a free log subscription operation binding the contract event 0x7445ea0496d2dd94d95c8760ebb7cbf2711d11384c1c5cf2b3b4657c5aa027d9.
+//
+// Solidity: event EpochRegistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) WatchEpochRegistered(opts *bind.WatchOpts, sink chan<- *DASignersEpochRegistered, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "EpochRegistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersEpochRegistered)
+				if err := _DASigners.contract.UnpackLog(event, "EpochRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochRegistered is a log parse operation binding the contract event 0x7445ea0496d2dd94d95c8760ebb7cbf2711d11384c1c5cf2b3b4657c5aa027d9.
+//
+// Solidity: event EpochRegistered(address indexed signer, uint256 epoch)
+func (_DASigners *DASignersFilterer) ParseEpochRegistered(log types.Log) (*DASignersEpochRegistered, error) {
+	event := new(DASignersEpochRegistered)
+	if err := _DASigners.contract.UnpackLog(event, "EpochRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // DASignersNewSignerIterator is returned from FilterNewSigner and is used to iterate over the raw logs and unpacked data for NewSigner events raised by the DASigners contract.
 type DASignersNewSignerIterator struct {
 	Event *DASignersNewSigner // Event containing the contract specifics and raw log
@@ -655,6 +1048,597 @@ func (_DASigners *DASignersFilterer) ParseNewSigner(log types.Log) (*DASignersNe
 	return event, nil
 }
 
+// DASignersRewardsClaimedIterator is returned from FilterRewardsClaimed and is used to iterate over the raw logs and unpacked data for RewardsClaimed events raised by the DASigners contract.
+type DASignersRewardsClaimedIterator struct {
+	Event *DASignersRewardsClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersRewardsClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersRewardsClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersRewardsClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersRewardsClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersRewardsClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersRewardsClaimed represents a RewardsClaimed event raised by the DASigners contract.
+type DASignersRewardsClaimed struct {
+	Signer common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterRewardsClaimed is a free log retrieval operation binding the contract event 0xfc30cddea38e2bf4d6ea7d3f9ed3b6ad7f176419f4963bd81318067a4aee73fe.
+//
+// Solidity: event RewardsClaimed(address indexed signer, uint256 amount)
+func (_DASigners *DASignersFilterer) FilterRewardsClaimed(opts *bind.FilterOpts, signer []common.Address) (*DASignersRewardsClaimedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "RewardsClaimed", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersRewardsClaimedIterator{contract: _DASigners.contract, event: "RewardsClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchRewardsClaimed is a free log subscription operation binding the contract event 0xfc30cddea38e2bf4d6ea7d3f9ed3b6ad7f176419f4963bd81318067a4aee73fe.
+//
+// Solidity: event RewardsClaimed(address indexed signer, uint256 amount)
+func (_DASigners *DASignersFilterer) WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *DASignersRewardsClaimed, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "RewardsClaimed", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersRewardsClaimed)
+				if err := _DASigners.contract.UnpackLog(event, "RewardsClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewardsClaimed is a log parse operation binding the contract event 0xfc30cddea38e2bf4d6ea7d3f9ed3b6ad7f176419f4963bd81318067a4aee73fe.
+//
+// Solidity: event RewardsClaimed(address indexed signer, uint256 amount)
+func (_DASigners *DASignersFilterer) ParseRewardsClaimed(log types.Log) (*DASignersRewardsClaimed, error) {
+	event := new(DASignersRewardsClaimed)
+	if err := _DASigners.contract.UnpackLog(event, "RewardsClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerAuthorizedIterator is returned from FilterSignerAuthorized and is used to iterate over the raw logs and unpacked data for SignerAuthorized events raised by the DASigners contract.
+type DASignersSignerAuthorizedIterator struct {
+	Event *DASignersSignerAuthorized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerAuthorizedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerAuthorized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerAuthorized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerAuthorizedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerAuthorizedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerAuthorized represents a SignerAuthorized event raised by the DASigners contract.
+type DASignersSignerAuthorized struct {
+	Operator       common.Address
+	Signer         common.Address
+	PreviousSigner common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerAuthorized is a free log retrieval operation binding the contract event 0xe666d54a6f51412d1b74b9715e8b123b76a8a0afced65393a84aec11fc0732e2.
+//
+// Solidity: event SignerAuthorized(address indexed operator, address indexed signer, address previousSigner)
+func (_DASigners *DASignersFilterer) FilterSignerAuthorized(opts *bind.FilterOpts, operator []common.Address, signer []common.Address) (*DASignersSignerAuthorizedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerAuthorized", operatorRule, signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerAuthorizedIterator{contract: _DASigners.contract, event: "SignerAuthorized", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerAuthorized is a free log subscription operation binding the contract event 0xe666d54a6f51412d1b74b9715e8b123b76a8a0afced65393a84aec11fc0732e2.
+//
+// Solidity: event SignerAuthorized(address indexed operator, address indexed signer, address previousSigner)
+func (_DASigners *DASignersFilterer) WatchSignerAuthorized(opts *bind.WatchOpts, sink chan<- *DASignersSignerAuthorized, operator []common.Address, signer []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerAuthorized", operatorRule, signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerAuthorized)
+				if err := _DASigners.contract.UnpackLog(event, "SignerAuthorized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerAuthorized is a log parse operation binding the contract event 0xe666d54a6f51412d1b74b9715e8b123b76a8a0afced65393a84aec11fc0732e2.
+//
+// Solidity: event SignerAuthorized(address indexed operator, address indexed signer, address previousSigner)
+func (_DASigners *DASignersFilterer) ParseSignerAuthorized(log types.Log) (*DASignersSignerAuthorized, error) {
+	event := new(DASignersSignerAuthorized)
+	if err := _DASigners.contract.UnpackLog(event, "SignerAuthorized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerDeregisteredIterator is returned from FilterSignerDeregistered and is used to iterate over the raw logs and unpacked data for SignerDeregistered events raised by the DASigners contract.
+type DASignersSignerDeregisteredIterator struct {
+	Event *DASignersSignerDeregistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerDeregisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerDeregistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerDeregistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerDeregisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerDeregisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerDeregistered represents a SignerDeregistered event raised by the DASigners contract.
+type DASignersSignerDeregistered struct {
+	Signer    common.Address
+	ExitEpoch *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerDeregistered is a free log retrieval operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) FilterSignerDeregistered(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerDeregisteredIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerDeregisteredIterator{contract: _DASigners.contract, event: "SignerDeregistered", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerDeregistered is a free log subscription operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) WatchSignerDeregistered(opts *bind.WatchOpts, sink chan<- *DASignersSignerDeregistered, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerDeregistered", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerDeregistered)
+				if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerDeregistered is a log parse operation binding the contract event 0x7e557e6be19f33d8e701018112ed15477885e56b5c9ecef9c68435c5f3759c51.
+//
+// Solidity: event SignerDeregistered(address indexed signer, uint256 exitEpoch)
+func (_DASigners *DASignersFilterer) ParseSignerDeregistered(log types.Log) (*DASignersSignerDeregistered, error) {
+	event := new(DASignersSignerDeregistered)
+	if err := _DASigners.contract.UnpackLog(event, "SignerDeregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DASignersSignerKeyRotatedIterator is returned from FilterSignerKeyRotated and is used to iterate over the raw logs and unpacked data for SignerKeyRotated events raised by the DASigners contract.
+type DASignersSignerKeyRotatedIterator struct {
+	Event *DASignersSignerKeyRotated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DASignersSignerKeyRotatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DASignersSignerKeyRotated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DASignersSignerKeyRotated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DASignersSignerKeyRotatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DASignersSignerKeyRotatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DASignersSignerKeyRotated represents a SignerKeyRotated event raised by the DASigners contract.
+type DASignersSignerKeyRotated struct {
+	Signer         common.Address
+	PkG1           BN254G1Point
+	PkG2           BN254G2Point
+	EffectiveEpoch *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignerKeyRotated is a free log retrieval operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) FilterSignerKeyRotated(opts *bind.FilterOpts, signer []common.Address) (*DASignersSignerKeyRotatedIterator, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.FilterLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DASignersSignerKeyRotatedIterator{contract: _DASigners.contract, event: "SignerKeyRotated", logs: logs, sub: sub}, nil
+}
+
+// WatchSignerKeyRotated is a free log subscription operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) WatchSignerKeyRotated(opts *bind.WatchOpts, sink chan<- *DASignersSignerKeyRotated, signer []common.Address) (event.Subscription, error) {
+
+	var signerRule []interface{}
+	for _, signerItem := range signer {
+		signerRule = append(signerRule, signerItem)
+	}
+
+	logs, sub, err := _DASigners.contract.WatchLogs(opts, "SignerKeyRotated", signerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DASignersSignerKeyRotated)
+				if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignerKeyRotated is a log parse operation binding the contract event 0x2f1687bbc4900ff3e371502a329db7d6e0a2b58cc504819ce1849778634250b1.
+//
+// Solidity: event SignerKeyRotated(address indexed signer, (uint256,uint256) pkG1, (uint256[2],uint256[2]) pkG2, uint256 effectiveEpoch)
+func (_DASigners *DASignersFilterer) ParseSignerKeyRotated(log types.Log) (*DASignersSignerKeyRotated, error) {
+	event := new(DASignersSignerKeyRotated)
+	if err := _DASigners.contract.UnpackLog(event, "SignerKeyRotated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // DASignersSocketUpdatedIterator is returned from FilterSocketUpdated and is used to iterate over the raw logs and unpacked data for SocketUpdated events raised by the DASigners contract.
 type DASignersSocketUpdatedIterator struct {
 	Event *DASignersSocketUpdated // Event containing the contract specifics and raw log
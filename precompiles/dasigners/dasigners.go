@@ -1,12 +1,10 @@
 package dasigners
 
 import (
-	"fmt"
-	"strings"
-
 	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
 	dasignerskeeper "github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -16,8 +14,6 @@ import (
 const (
 	PrecompileAddress = "0x0000000000000000000000000000000000001000"
 
-	RequiredGasMax uint64 = 1000_000_000
-
 	DASignersFunctionEpochNumber       = "epochNumber"
 	DASignersFunctionQuorumCount       = "quorumCount"
 	DASignersFunctionGetSigner         = "getSigner"
@@ -29,48 +25,65 @@ const (
 	DASignersFunctionGetAggPkG1        = "getAggPkG1"
 	DASignersFunctionIsSigner          = "isSigner"
 	DASignersFunctionRegisteredEpoch   = "registeredEpoch"
+	DASignersFunctionDeregisterSigner  = "deregisterSigner"
+	DASignersFunctionVerifyAggSig      = "verifyAggSig"
+	DASignersFunctionRotateSignerKey   = "rotateSignerKey"
+	DASignersFunctionPendingRewards    = "pendingRewards"
+	DASignersFunctionClaimRewards      = "claimRewards"
+	DASignersFunctionGetEpochParams    = "getEpochParams"
+	DASignersFunctionSignerOperator    = "signerOperator"
+	DASignersFunctionAuthorizeSigner   = "authorizeSigner"
+	DASignersFunctionRegisterOperated  = "registerOperatedSigner"
 )
 
+// RequiredGasBasic is charged before running a method, the store access, pairings and key aggregation are metered on
+// top of it by the gas schedule in the dasigners params
 var RequiredGasBasic = map[string]uint64{
 	DASignersFunctionEpochNumber:       1000,
 	DASignersFunctionQuorumCount:       1000,
-	DASignersFunctionGetSigner:         100000,
-	DASignersFunctionGetQuorum:         100000,
-	DASignersFunctionGetQuorumRow:      10000,
-	DASignersFunctionRegisterSigner:    100000,
-	DASignersFunctionUpdateSocket:      50000,
-	DASignersFunctionRegisterNextEpoch: 100000,
-	DASignersFunctionGetAggPkG1:        1000000,
-	DASignersFunctionIsSigner:          10000,
-	DASignersFunctionRegisteredEpoch:   10000,
-}
-
-var KVGasConfig storetypes.GasConfig = storetypes.GasConfig{
-	HasCost:          0,
-	DeleteCost:       0,
-	ReadCostFlat:     0,
-	ReadCostPerByte:  0,
-	WriteCostFlat:    0,
-	WriteCostPerByte: 0,
-	IterNextCostFlat: 0,
+	DASignersFunctionGetSigner:         5000,
+	DASignersFunctionGetQuorum:         5000,
+	DASignersFunctionGetQuorumRow:      3000,
+	DASignersFunctionRegisterSigner:    20000,
+	DASignersFunctionUpdateSocket:      10000,
+	DASignersFunctionRegisterNextEpoch: 20000,
+	DASignersFunctionGetAggPkG1:        10000,
+	DASignersFunctionIsSigner:          3000,
+	DASignersFunctionRegisteredEpoch:   3000,
+	DASignersFunctionDeregisterSigner:  10000,
+	DASignersFunctionVerifyAggSig:      10000,
+	DASignersFunctionRotateSignerKey:   20000,
+	DASignersFunctionPendingRewards:    3000,
+	DASignersFunctionClaimRewards:      10000,
+	DASignersFunctionGetEpochParams:    3000,
+	DASignersFunctionSignerOperator:    3000,
+	DASignersFunctionAuthorizeSigner:   10000,
+	DASignersFunctionRegisterOperated:  20000,
 }
 
 var _ vm.PrecompiledContract = &DASignersPrecompile{}
 
 type DASignersPrecompile struct {
-	abi             abi.ABI
+	precopmiles_common.PrecompileBase
 	dasignersKeeper dasignerskeeper.Keeper
 }
 
 func NewDASignersPrecompile(dasignersKeeper dasignerskeeper.Keeper) (*DASignersPrecompile, error) {
-	abi, err := abi.JSON(strings.NewReader(DASignersABI))
+	base, err := precopmiles_common.NewPrecompileBase(DASignersABI, RequiredGasBasic)
 	if err != nil {
 		return nil, err
 	}
-	return &DASignersPrecompile{
-		abi:             abi,
+	precompile := &DASignersPrecompile{
+		PrecompileBase:  base,
 		dasignersKeeper: dasignersKeeper,
-	}, nil
+	}
+	precompile.PrecompileBase.KVGasConfig = precompile.kvGasConfig
+	return precompile, nil
+}
+
+// kvGasConfig meters the store access with the gas schedule in force
+func (d *DASignersPrecompile) kvGasConfig(ctx sdk.Context) storetypes.GasConfig {
+	return d.dasignersKeeper.GetParams(ctx).GasSchedule.KVGasConfig()
 }
 
 // Address implements vm.PrecompiledContract.
@@ -78,78 +91,53 @@ func (d *DASignersPrecompile) Address() common.Address {
 	return common.HexToAddress(PrecompileAddress)
 }
 
-// RequiredGas implements vm.PrecompiledContract.
-func (d *DASignersPrecompile) RequiredGas(input []byte) uint64 {
-	method, err := d.abi.MethodById(input[:4])
-	if err != nil {
-		return RequiredGasMax
-	}
-	if gas, ok := RequiredGasBasic[method.Name]; ok {
-		return gas
-	}
-	return RequiredGasMax
-}
-
 // Run implements vm.PrecompiledContract.
 func (d *DASignersPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
-	// parse input
-	if len(contract.Input) < 4 {
-		return nil, vm.ErrExecutionReverted
-	}
-	method, err := d.abi.MethodById(contract.Input[:4])
-	if err != nil {
+	return d.Execute(evm, contract, readonly, func(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+		switch method.Name {
+		// queries
+		case DASignersFunctionEpochNumber:
+			return d.EpochNumber(ctx, evm, method, args)
+		case DASignersFunctionQuorumCount:
+			return d.QuorumCount(ctx, evm, method, args)
+		case DASignersFunctionGetSigner:
+			return d.GetSigner(ctx, evm, method, args)
+		case DASignersFunctionGetQuorum:
+			return d.GetQuorum(ctx, evm, method, args)
+		case DASignersFunctionGetQuorumRow:
+			return d.GetQuorumRow(ctx, evm, method, args)
+		case DASignersFunctionGetAggPkG1:
+			return d.GetAggPkG1(ctx, evm, method, args)
+		case DASignersFunctionIsSigner:
+			return d.IsSigner(ctx, evm, method, args)
+		case DASignersFunctionRegisteredEpoch:
+			return d.RegisteredEpoch(ctx, evm, method, args)
+		case DASignersFunctionVerifyAggSig:
+			return d.VerifyAggSig(ctx, evm, method, args)
+		case DASignersFunctionPendingRewards:
+			return d.PendingRewards(ctx, evm, method, args)
+		case DASignersFunctionGetEpochParams:
+			return d.GetEpochParams(ctx, evm, method, args)
+		case DASignersFunctionSignerOperator:
+			return d.SignerOperator(ctx, evm, method, args)
+		// txs
+		case DASignersFunctionRegisterSigner:
+			return d.RegisterSigner(ctx, evm, stateDB, method, args)
+		case DASignersFunctionRegisterNextEpoch:
+			return d.RegisterNextEpoch(ctx, evm, stateDB, method, args)
+		case DASignersFunctionUpdateSocket:
+			return d.UpdateSocket(ctx, evm, stateDB, method, args)
+		case DASignersFunctionDeregisterSigner:
+			return d.DeregisterSigner(ctx, contract, stateDB, method, args)
+		case DASignersFunctionRotateSignerKey:
+			return d.RotateSignerKey(ctx, contract, stateDB, method, args)
+		case DASignersFunctionClaimRewards:
+			return d.ClaimRewards(ctx, contract, stateDB, method, args)
+		case DASignersFunctionAuthorizeSigner:
+			return d.AuthorizeSigner(ctx, contract, stateDB, method, args)
+		case DASignersFunctionRegisterOperated:
+			return d.RegisterOperatedSigner(ctx, contract, stateDB, method, args)
+		}
 		return nil, vm.ErrExecutionReverted
-	}
-	args, err := method.Inputs.Unpack(contract.Input[4:])
-	if err != nil {
-		return nil, err
-	}
-	// get state db and context
-	stateDB, ok := evm.StateDB.(*statedb.StateDB)
-	if !ok {
-		return nil, fmt.Errorf(precopmiles_common.ErrGetStateDB)
-	}
-	ctx := stateDB.GetContext()
-	// reset gas config
-	ctx = ctx.WithKVGasConfig(KVGasConfig)
-	initialGas := ctx.GasMeter().GasConsumed()
-
-	var bz []byte
-	switch method.Name {
-	// queries
-	case DASignersFunctionEpochNumber:
-		bz, err = d.EpochNumber(ctx, evm, method, args)
-	case DASignersFunctionQuorumCount:
-		bz, err = d.QuorumCount(ctx, evm, method, args)
-	case DASignersFunctionGetSigner:
-		bz, err = d.GetSigner(ctx, evm, method, args)
-	case DASignersFunctionGetQuorum:
-		bz, err = d.GetQuorum(ctx, evm, method, args)
-	case DASignersFunctionGetQuorumRow:
-		bz, err = d.GetQuorumRow(ctx, evm, method, args)
-	case DASignersFunctionGetAggPkG1:
-		bz, err = d.GetAggPkG1(ctx, evm, method, args)
-	case DASignersFunctionIsSigner:
-		bz, err = d.IsSigner(ctx, evm, method, args)
-	case DASignersFunctionRegisteredEpoch:
-		bz, err = d.RegisteredEpoch(ctx, evm, method, args)
-	// txs
-	case DASignersFunctionRegisterSigner:
-		bz, err = d.RegisterSigner(ctx, evm, stateDB, method, args)
-	case DASignersFunctionRegisterNextEpoch:
-		bz, err = d.RegisterNextEpoch(ctx, evm, stateDB, method, args)
-	case DASignersFunctionUpdateSocket:
-		bz, err = d.UpdateSocket(ctx, evm, stateDB, method, args)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	cost := ctx.GasMeter().GasConsumed() - initialGas
-
-	if !contract.UseGas(cost) {
-		return nil, vm.ErrOutOfGas
-	}
-	return bz, nil
+	})
 }
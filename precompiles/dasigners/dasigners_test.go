@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/0glabs/0g-chain/chaincfg"
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	dasignersprecompile "github.com/0glabs/0g-chain/precompiles/dasigners"
 	"github.com/0glabs/0g-chain/precompiles/testutil"
@@ -23,6 +24,7 @@ import (
 	"cosmossdk.io/math"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 )
 
@@ -53,12 +55,18 @@ func (suite *DASignersTestSuite) AddDelegation(from string, to string, amount ma
 	validator.Tokens = validator.Tokens.Add(amount)
 	validator.DelegatorShares = validator.DelegatorShares.Add(amount.ToLegacyDec())
 	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
-	bonded := suite.dasignerskeeper.GetDelegatorBonded(suite.Ctx, accAddr)
+	shares := amount.ToLegacyDec()
+	if delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddr); found {
+		shares = shares.Add(delegation.Shares)
+	}
 	suite.StakingKeeper.SetDelegation(suite.Ctx, stakingtypes.Delegation{
 		DelegatorAddress: accAddr.String(),
 		ValidatorAddress: valAddr.String(),
-		Shares:           bonded.Add(amount).ToLegacyDec(),
+		Shares:           shares,
 	})
+	// SetDelegation bypasses the staking hooks
+	err = suite.dasignerskeeper.Hooks().AfterDelegationModified(suite.Ctx, accAddr, valAddr)
+	suite.Require().NoError(err)
 }
 
 func (suite *DASignersTestSuite) SetupTest() {
@@ -81,10 +89,16 @@ func (suite *DASignersTestSuite) SetupTest() {
 }
 
 func (suite *DASignersTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
-	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), big.NewInt(0), gas)
+	bz, _, err := suite.runCall(input, signer, gas, big.NewInt(0), false)
+	return bz, err
+}
+
+// runCall returns the output of the call together with the gas it used
+func (suite *DASignersTestSuite) runCall(input []byte, signer *testutil.TestSigner, gas uint64, value *big.Int, readonly bool) ([]byte, uint64, error) {
+	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), value, gas)
 	contract.Input = input
 
-	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
+	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, value, gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
 	msgEthereumTx.From = signer.HexAddr
 	err := msgEthereumTx.Sign(suite.EthSigner, signer.Signer)
 	suite.Assert().NoError(err, "failed to sign Ethereum message")
@@ -100,7 +114,8 @@ func (suite *DASignersTestSuite) runTx(input []byte, signer *testutil.TestSigner
 	precompiles := suite.EvmKeeper.GetPrecompiles()
 	evm.WithPrecompiles(precompiles, []common.Address{suite.addr})
 
-	return suite.dasigners.Run(evm, contract, false)
+	bz, err := suite.dasigners.Run(evm, contract, readonly)
+	return bz, gas - contract.Gas, err
 }
 
 func (suite *DASignersTestSuite) registerSigner(testSigner *testutil.TestSigner, sk *big.Int) *types.Signer {
@@ -135,6 +150,67 @@ func (suite *DASignersTestSuite) registerSigner(testSigner *testutil.TestSigner,
 	return signer
 }
 
+func (suite *DASignersTestSuite) registerOperatedSigner(testSigner *testutil.TestSigner, sk *big.Int, operator common.Address) {
+	pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)
+	pkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+	hash := types.PubkeyRegistrationHash(testSigner.Addr, big.NewInt(8888))
+	signature := new(bn254.G1Affine).ScalarMultiplication(hash, sk)
+	signer := &types.Signer{
+		Account:  testSigner.HexAddr,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: bn254util.SerializeG1(pkG1),
+		PubkeyG2: bn254util.SerializeG2(pkG2),
+	}
+
+	input, err := suite.abi.Pack(
+		"registerOperatedSigner",
+		dasignersprecompile.NewIDASignersSignerDetail(signer),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(signature)),
+		operator,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+2)
+}
+
+func (suite *DASignersTestSuite) authorizeSigner(testSigner *testutil.TestSigner, signer common.Address, previousSigner common.Address) {
+	input, err := suite.abi.Pack(
+		"authorizeSigner",
+		signer,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	suite.Assert().EqualValues(logs[len(logs)-1].Topics[1], common.BytesToHash(testSigner.Addr.Bytes()))
+	suite.Assert().EqualValues(logs[len(logs)-1].Topics[2], common.BytesToHash(signer.Bytes()))
+	out, err := suite.abi.Unpack("SignerAuthorized", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(previousSigner, out[0].(common.Address))
+}
+
+func (suite *DASignersTestSuite) querySignerOperator(testSigner *testutil.TestSigner, account common.Address) common.Address {
+	input, err := suite.abi.Pack(
+		"signerOperator",
+		account,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["signerOperator"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(common.Address)
+}
+
 func (suite *DASignersTestSuite) updateSocket(testSigner *testutil.TestSigner, signer *types.Signer) {
 	input, err := suite.abi.Pack(
 		"updateSocket",
@@ -164,8 +240,117 @@ func (suite *DASignersTestSuite) registerEpoch(testSigner *testutil.TestSigner,
 	)
 	suite.Assert().NoError(err)
 
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("EpochRegistered", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], big.NewInt(1))
+}
+
+func (suite *DASignersTestSuite) deregisterSigner(testSigner *testutil.TestSigner) {
+	input, err := suite.abi.Pack(
+		"deregisterSigner",
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("SignerDeregistered", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	suite.Assert().EqualValues(out[0], big.NewInt(int64(2+params.ExitCooldownEpochs)))
+
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().ErrorIs(err, types.ErrSignerExiting)
+}
+
+func (suite *DASignersTestSuite) rotateSignerKey(testSigner *testutil.TestSigner, sk *big.Int, nonce uint64) {
+	pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)
+	pkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+	hash := types.PubkeyRotationHash(testSigner.Addr, big.NewInt(8888), nonce)
+	signature := new(bn254.G1Affine).ScalarMultiplication(hash, sk)
+
+	input, err := suite.abi.Pack(
+		"rotateSignerKey",
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(pkG1)),
+		dasignersprecompile.NewBN254G2Point(bn254util.SerializeG2(pkG2)),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(signature)),
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("SignerKeyRotated", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(pkG1)))
+	suite.Assert().EqualValues(out[2], big.NewInt(2))
+
+	// replayed proof of possession
 	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().ErrorIs(err, types.ErrInvalidSignature)
+}
+
+func (suite *DASignersTestSuite) claimRewards(testSigner *testutil.TestSigner, amount *big.Int) {
+	input, err := suite.abi.Pack(
+		"claimRewards",
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["claimRewards"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], amount)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err = suite.abi.Unpack("RewardsClaimed", logs[len(logs)-1].Data)
 	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], amount)
+
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().ErrorIs(err, types.ErrNoRewards)
+}
+
+func (suite *DASignersTestSuite) queryPendingRewards(testSigner *testutil.TestSigner, account common.Address) *big.Int {
+	input, err := suite.abi.Pack(
+		"pendingRewards",
+		account,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["pendingRewards"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(*big.Int)
+}
+
+func (suite *DASignersTestSuite) queryGetEpochParams(testSigner *testutil.TestSigner, epoch *big.Int) dasignersprecompile.IDASignersParams {
+	input, err := suite.abi.Pack(
+		"getEpochParams",
+		epoch,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["getEpochParams"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return *abi.ConvertType(out[0], new(dasignersprecompile.IDASignersParams)).(*dasignersprecompile.IDASignersParams)
 }
 
 func (suite *DASignersTestSuite) queryEpochNumber(testSigner *testutil.TestSigner) {
@@ -301,6 +486,37 @@ func (suite *DASignersTestSuite) queryGetAggPkG1(testSigner *testutil.TestSigner
 	}
 }
 
+func (suite *DASignersTestSuite) queryVerifyAggSig(testSigner *testutil.TestSigner, bitmap []byte, msgHash [32]byte, sk *big.Int) struct {
+	Valid bool
+	Total *big.Int
+	Hit   *big.Int
+} {
+	signature := new(bn254.G1Affine).ScalarMultiplication(bn254util.MapToCurve(msgHash), sk)
+	input, err := suite.abi.Pack(
+		"verifyAggSig",
+		big.NewInt(1),
+		big.NewInt(0),
+		bitmap,
+		msgHash,
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(signature)),
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["verifyAggSig"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return struct {
+		Valid bool
+		Total *big.Int
+		Hit   *big.Int
+	}{
+		Valid: out[0].(bool),
+		Total: out[1].(*big.Int),
+		Hit:   out[2].(*big.Int),
+	}
+}
+
 func (suite *DASignersTestSuite) Test_DASigners() {
 	// suite.App.InitializeFromGenesisStates()
 	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
@@ -315,12 +531,22 @@ func (suite *DASignersTestSuite) Test_DASigners() {
 	suite.updateSocket(suite.signerTwo, signer2)
 	suite.registerEpoch(suite.signerOne, big.NewInt(1))
 	suite.registerEpoch(suite.signerTwo, big.NewInt(11))
+	// fund the reward pool
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300)))
+	suite.Require().NoError(err)
 	// move to next epoch
 	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 1)
 	suite.dasignerskeeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
 	// query test
 	suite.queryEpochNumber(suite.signerOne)
 	suite.queryQuorumCount(suite.signerOne)
+	// the params snapshot of the epoch is kept when the params change
+	newParams := params
+	newParams.EncodedSlices = params.EncodedSlices * 2
+	suite.dasignerskeeper.SetParams(suite.Ctx, newParams)
+	suite.Assert().EqualValues(dasignersprecompile.NewIDASignersParams(params), suite.queryGetEpochParams(suite.signerOne, big.NewInt(1)))
+	suite.dasignerskeeper.SetParams(suite.Ctx, params)
 	suite.queryGetSigner(suite.signerOne, []*types.Signer{signer1, signer2})
 	suite.queryIsSigner(suite.signerOne)
 	suite.Assert().EqualValues(suite.queryRegisteredEpoch(suite.signerOne, suite.signerOne.Addr, big.NewInt(1)), true)
@@ -368,6 +594,265 @@ func (suite *DASignersTestSuite) Test_DASigners() {
 		Hit:     big.NewInt(int64(len(quorum))),
 	})
 
+	msgHash := crypto.Keccak256Hash([]byte("da blob"))
+	suite.Assert().EqualValues(suite.queryVerifyAggSig(suite.signerOne, bitMap, msgHash, big.NewInt(1+11)), struct {
+		Valid bool
+		Total *big.Int
+		Hit   *big.Int
+	}{
+		Valid: true,
+		Total: big.NewInt(int64(len(quorum))),
+		Hit:   big.NewInt(int64(len(quorum))),
+	})
+	suite.Assert().EqualValues(suite.queryVerifyAggSig(suite.signerOne, bitMap, msgHash, big.NewInt(1)).Valid, false)
+
+	// the rotated key is used from the next epoch, the formed quorums still verify with the old key
+	suite.rotateSignerKey(suite.signerOne, big.NewInt(2), 1)
+	suite.Assert().EqualValues(suite.queryVerifyAggSig(suite.signerOne, bitMap, msgHash, big.NewInt(1+11)).Valid, true)
+
+	// the pool is split by ballots, signer two holds twice the ballots of signer one
+	suite.Assert().EqualValues(suite.queryPendingRewards(suite.signerOne, suite.signerTwo.Addr), big.NewInt(200))
+	suite.claimRewards(suite.signerOne, big.NewInt(100))
+	suite.Assert().Zero(suite.queryPendingRewards(suite.signerOne, suite.signerOne.Addr).Sign())
+
+	suite.deregisterSigner(suite.signerTwo)
+}
+
+func (suite *DASignersTestSuite) Test_AuthorizeSigner() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	// the stake stays with the operator, the signer holds no delegations
+	suite.AddDelegation(suite.signerTwo.HexAddr, suite.signerTwo.HexAddr, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+
+	suite.authorizeSigner(suite.signerTwo, suite.signerOne.Addr, common.Address{})
+	// the link is made when the signer accepts the authorization by registering with the operator, until then the
+	// signer stakes for itself
+	suite.Assert().EqualValues(suite.signerOne.Addr, suite.querySignerOperator(suite.signerOne, suite.signerOne.Addr))
+	suite.registerOperatedSigner(suite.signerOne, big.NewInt(1), suite.signerTwo.Addr)
+	suite.Assert().EqualValues(suite.signerTwo.Addr, suite.querySignerOperator(suite.signerOne, suite.signerOne.Addr))
+	suite.Assert().EqualValues(suite.signerTwo.Addr, suite.querySignerOperator(suite.signerOne, suite.signerTwo.Addr))
+	suite.registerEpoch(suite.signerOne, big.NewInt(1))
+	suite.Assert().EqualValues(suite.queryRegisteredEpoch(suite.signerOne, suite.signerOne.Addr, big.NewInt(1)), true)
+}
+
+// Test_ContractCaller checks that a contract called by a signer acts on its own behalf, not on the one of the signer
+func (suite *DASignersTestSuite) Test_ContractCaller() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	suite.AddDelegation(suite.signerOne.HexAddr, suite.signerOne.HexAddr, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.registerSigner(suite.signerOne, big.NewInt(1))
+	forwarder := suite.DeployForwarder(suite.addr)
+
+	input, err := suite.abi.Pack("deregisterSigner")
+	suite.Require().NoError(err)
+	res := suite.ApplyMessage(suite.signerOne, forwarder, big.NewInt(0), input, 10000000)
+	suite.Assert().True(res.Failed())
+	_, exiting, err := suite.dasignerskeeper.GetSignerExit(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Assert().False(exiting)
+
+	// the proof of possession binds the signer address, the forwarder cannot rotate the signer key with it
+	sk := big.NewInt(2)
+	hash := types.PubkeyRotationHash(suite.signerOne.Addr, big.NewInt(8888), 1)
+	input, err = suite.abi.Pack(
+		"rotateSignerKey",
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk))),
+		dasignersprecompile.NewBN254G2Point(bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk))),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk))),
+	)
+	suite.Require().NoError(err)
+	res = suite.ApplyMessage(suite.signerOne, forwarder, big.NewInt(0), input, 10000000)
+	suite.Assert().True(res.Failed())
+	_, found, err := suite.dasignerskeeper.GetSignerKeyRotation(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+
+	// the authorization is made by the forwarder, not by the account that called it
+	input, err = suite.abi.Pack("authorizeSigner", suite.signerTwo.Addr)
+	suite.Require().NoError(err)
+	res = suite.ApplyMessage(suite.signerOne, forwarder, big.NewInt(0), input, 10000000)
+	suite.Require().False(res.Failed())
+	signer, found, err := suite.dasignerskeeper.GetPendingAuthorization(suite.Ctx, suite.signerOne.HexAddr)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	signer, found, err = suite.dasignerskeeper.GetPendingAuthorization(suite.Ctx, dasignersprecompile.ToLowerHexWithoutPrefix(forwarder))
+	suite.Require().NoError(err)
+	suite.Assert().True(found)
+	suite.Assert().EqualValues(suite.signerTwo.HexAddr, signer)
+
+	// the forwarder cannot register the signer of the account that called it
+	hash = types.PubkeyRegistrationHash(suite.signerTwo.Addr, big.NewInt(8888))
+	input, err = suite.abi.Pack(
+		"registerOperatedSigner",
+		dasignersprecompile.NewIDASignersSignerDetail(&types.Signer{
+			Account:  suite.signerTwo.HexAddr,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+			PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+		}),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk))),
+		suite.signerOne.Addr,
+	)
+	suite.Require().NoError(err)
+	res = suite.ApplyMessage(suite.signerTwo, forwarder, big.NewInt(0), input, 10000000)
+	suite.Assert().True(res.Failed())
+	_, found, err = suite.dasignerskeeper.GetSigner(suite.Ctx, suite.signerTwo.HexAddr)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+}
+
+func (suite *DASignersTestSuite) Test_CallRestrictions() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+
+	// queries are served in a read only context
+	input, err := suite.abi.Pack("epochNumber")
+	suite.Assert().NoError(err)
+	_, _, err = suite.runCall(input, suite.signerOne, 10000000, big.NewInt(0), true)
+	suite.Assert().NoError(err)
+
+	// txs are rejected in a read only context
+	input, err = suite.abi.Pack("updateSocket", "0.0.0.0:1234")
+	suite.Assert().NoError(err)
+	_, _, err = suite.runCall(input, suite.signerOne, 10000000, big.NewInt(0), true)
+	suite.Assert().ErrorIs(err, vm.ErrWriteProtection)
+
+	// value is rejected by non-payable methods
+	_, _, err = suite.runCall(input, suite.signerOne, 10000000, big.NewInt(1), false)
+	suite.Assert().ErrorContains(err, "not payable")
+	input, err = suite.abi.Pack("epochNumber")
+	suite.Assert().NoError(err)
+	_, _, err = suite.runCall(input, suite.signerOne, 10000000, big.NewInt(1), false)
+	suite.Assert().ErrorContains(err, "not payable")
+}
+
+func (suite *DASignersTestSuite) Test_GasSchedule() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	params := suite.dasignerskeeper.GetParams(suite.Ctx)
+	suite.AddDelegation(suite.signerOne.HexAddr, suite.signerOne.HexAddr, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.AddDelegation(suite.signerTwo.HexAddr, suite.signerOne.HexAddr, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.registerSigner(suite.signerOne, big.NewInt(1))
+	suite.registerSigner(suite.signerTwo, big.NewInt(11))
+	suite.registerEpoch(suite.signerOne, big.NewInt(1))
+	suite.registerEpoch(suite.signerTwo, big.NewInt(11))
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 1)
+	suite.dasignerskeeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+
+	quorum := suite.queryGetQuorum(suite.signerOne)
+	bitmapOne := make([]byte, (len(quorum)+7)/8)
+	bitmapAll := make([]byte, (len(quorum)+7)/8)
+	for i, v := range quorum {
+		if v == suite.signerOne.Addr {
+			bitmapOne[i/8] |= 1 << (i % 8)
+		}
+		bitmapAll[i/8] |= 1 << (i % 8)
+	}
+	aggGas := func(bitmap []byte) uint64 {
+		input, err := suite.abi.Pack("getAggPkG1", big.NewInt(1), big.NewInt(0), bitmap)
+		suite.Assert().NoError(err)
+		_, gas, err := suite.runCall(input, suite.signerOne, 10000000, big.NewInt(0), true)
+		suite.Assert().NoError(err)
+		return gas
+	}
+
+	// the aggregation is charged per signer
+	gasOne := aggGas(bitmapOne)
+	gasAll := aggGas(bitmapAll)
+	suite.Assert().GreaterOrEqual(gasAll-gasOne, params.GasSchedule.AggregateCostPerSigner)
+
+	// the schedule is governed by the params
+	free := params
+	free.GasSchedule = types.GasSchedule{}
+	suite.dasignerskeeper.SetParams(suite.Ctx, free)
+	suite.Assert().Zero(aggGas(bitmapAll))
+	suite.dasignerskeeper.SetParams(suite.Ctx, params)
+
+	// the call is aborted when the metered gas exceeds the gas left
+	input, err := suite.abi.Pack("getAggPkG1", big.NewInt(1), big.NewInt(0), bitmapAll)
+	suite.Assert().NoError(err)
+	_, _, err = suite.runCall(input, suite.signerOne, gasAll-1, big.NewInt(0), true)
+	suite.Assert().ErrorIs(err, vm.ErrOutOfGas)
+
+	// the signature checks of the txs are charged
+	sk := big.NewInt(2)
+	hash := types.PubkeyRotationHash(suite.signerOne.Addr, big.NewInt(8888), 1)
+	input, err = suite.abi.Pack(
+		"rotateSignerKey",
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk))),
+		dasignersprecompile.NewBN254G2Point(bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk))),
+		dasignersprecompile.NewBN254G1Point(bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk))),
+	)
+	suite.Assert().NoError(err)
+	_, gas, err := suite.runCall(input, suite.signerOne, 10000000, big.NewInt(0), false)
+	suite.Assert().NoError(err)
+	suite.Assert().GreaterOrEqual(gas, params.GasSchedule.PairingCost)
+}
+
+func (suite *DASignersTestSuite) Test_OutOfGasDiscardsWrites() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	err := suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.dasignerskeeper.SetSignerRewards(suite.Ctx, suite.signerOne.HexAddr, sdk.NewInt(100)))
+	suite.dasignerskeeper.SetOutstandingRewards(suite.Ctx, sdk.NewInt(100))
+	input, err := suite.abi.Pack("claimRewards")
+	suite.Require().NoError(err)
+
+	// every gas limit short of the cost runs out of gas at some step of the claim, nothing of it is kept
+	for gas := uint64(0); ; gas += 500 {
+		_, _, err := suite.runCall(input, suite.signerOne, gas, big.NewInt(0), false)
+		if err == nil {
+			break
+		}
+		suite.Require().ErrorIs(err, vm.ErrOutOfGas)
+		rewards, err := suite.dasignerskeeper.GetSignerRewards(suite.Ctx, suite.signerOne.HexAddr)
+		suite.Require().NoError(err)
+		suite.Require().EqualValues(sdk.NewInt(100), rewards)
+		suite.Require().EqualValues(sdk.NewInt(100), suite.dasignerskeeper.GetOutstandingRewards(suite.Ctx))
+	}
+	suite.Assert().Zero(suite.dasignerskeeper.GetOutstandingRewards(suite.Ctx).Sign())
+	suite.Assert().EqualValues(sdk.NewInt(100), suite.App.GetBankKeeper().GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), bondDenom).Amount)
+}
+
+func (suite *DASignersTestSuite) Test_ClaimRewardsCommit() {
+	dasigners.InitGenesis(suite.Ctx, suite.dasignerskeeper, *types.DefaultGenesisState())
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	bankKeeper := suite.App.GetBankKeeper()
+	// the claimants are contracts receiving value in the tx, so the EVM state commits their balances
+	claimant := suite.DeployForwarder(suite.addr)
+	reverting := suite.DeployRevertingCaller(suite.addr)
+	suite.Require().NoError(suite.App.FundModuleAccount(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.signerOne.Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	for _, account := range []common.Address{suite.signerOne.Addr, claimant, reverting} {
+		suite.Require().NoError(suite.dasignerskeeper.SetSignerRewards(suite.Ctx, dasignersprecompile.ToLowerHexWithoutPrefix(account), sdk.NewInt(100)))
+	}
+	suite.dasignerskeeper.SetOutstandingRewards(suite.Ctx, sdk.NewInt(300))
+	supply := bankKeeper.GetSupply(suite.Ctx, bondDenom)
+	value := new(big.Int).Mul(big.NewInt(10), big.NewInt(chaincfg.GasDenomConversionMultiplier))
+	input, err := suite.abi.Pack("claimRewards")
+	suite.Require().NoError(err)
+
+	// the rewards of the calling contract are claimed, not the ones of the origin
+	res := suite.ApplyMessage(suite.signerOne, claimant, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Assert().EqualValues(sdk.NewInt(110), bankKeeper.GetBalance(suite.Ctx, claimant.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(990), bankKeeper.GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(200), suite.dasignerskeeper.GetOutstandingRewards(suite.Ctx))
+	suite.Assert().EqualValues(big.NewInt(100), suite.queryPendingRewards(suite.signerOne, suite.signerOne.Addr))
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
+
+	// a failed claim moves nothing
+	res = suite.ApplyMessage(suite.signerOne, claimant, value, input, 10000000)
+	suite.Require().True(res.Failed())
+	suite.Assert().EqualValues(sdk.NewInt(110), bankKeeper.GetBalance(suite.Ctx, claimant.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
+
+	// a claim in a call frame reverting afterwards is reverted with it
+	res = suite.ApplyMessage(suite.signerOne, reverting, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Assert().EqualValues(sdk.NewInt(10), bankKeeper.GetBalance(suite.Ctx, reverting.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(200), suite.dasignerskeeper.GetOutstandingRewards(suite.Ctx))
+	suite.Assert().EqualValues(big.NewInt(100), suite.queryPendingRewards(suite.signerOne, reverting))
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
 }
 
 func TestKeeperSuite(t *testing.T) {
@@ -111,3 +111,51 @@ func (d *DASignersPrecompile) GetAggPkG1(ctx sdk.Context, _ *vm.EVM, method *abi
 	}
 	return method.Outputs.Pack(NewBN254G1Point(response.AggregatePubkeyG1), big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
 }
+
+func (d *DASignersPrecompile) VerifyAggSig(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryVerifyAggregateSignatureRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.VerifyAggregateSignature(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(response.Valid, big.NewInt(int64(response.Total)), big.NewInt(int64(response.Hit)))
+}
+
+func (d *DASignersPrecompile) PendingRewards(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryPendingRewardsRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.PendingRewards(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(response.Amount.BigInt())
+}
+
+func (d *DASignersPrecompile) GetEpochParams(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryEpochParamsRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.EpochParams(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(NewIDASignersParams(response.Params))
+}
+
+func (d *DASignersPrecompile) SignerOperator(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQuerySignerOperatorRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.dasignersKeeper.SignerOperator(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(common.HexToAddress(response.Operator))
+}
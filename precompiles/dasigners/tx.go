@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/statedb"
 )
@@ -19,6 +20,8 @@ func (d *DASignersPrecompile) RegisterSigner(ctx sdk.Context, evm *vm.EVM, state
 	if sender != msg.Signer.Account {
 		return nil, fmt.Errorf(ErrInvalidSender, sender, msg.Signer.Account)
 	}
+	// the signature check is metered by the gas schedule
+	d.dasignersKeeper.ConsumePairingGas(ctx)
 	// execute
 	_, err = d.dasignersKeeper.RegisterSigner(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
@@ -37,8 +40,15 @@ func (d *DASignersPrecompile) RegisterNextEpoch(ctx sdk.Context, evm *vm.EVM, st
 	if err != nil {
 		return nil, err
 	}
+	// the signature check is metered by the gas schedule
+	d.dasignersKeeper.ConsumePairingGas(ctx)
 	// execute
-	_, err = d.dasignersKeeper.RegisterNextEpoch(sdk.WrapSDKContext(ctx), msg)
+	response, err := d.dasignersKeeper.RegisterNextEpoch(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitEpochRegisteredEvent(ctx, stateDB, evm.Origin, response.Epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -62,3 +72,102 @@ func (d *DASignersPrecompile) UpdateSocket(ctx sdk.Context, evm *vm.EVM, stateDB
 	}
 	return method.Outputs.Pack()
 }
+
+func (d *DASignersPrecompile) DeregisterSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgDeregisterSigner(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.dasignersKeeper.DeregisterSigner(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerDeregisteredEvent(ctx, stateDB, contract.Caller(), response.ExitEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) RotateSignerKey(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRotateSignerKey(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// the signature check is metered by the gas schedule
+	d.dasignersKeeper.ConsumePairingGas(ctx)
+	// execute
+	response, err := d.dasignersKeeper.RotateSignerKey(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerKeyRotatedEvent(ctx, stateDB, contract.Caller(), args[0].(BN254G1Point), args[1].(BN254G2Point), response.EffectiveEpoch)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) ClaimRewards(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgClaimRewards(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.dasignersKeeper.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitRewardsClaimedEvent(ctx, stateDB, contract.Caller(), response.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(response.Amount.BigInt())
+}
+
+func (d *DASignersPrecompile) RegisterOperatedSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgRegisterOperatedSigner(args)
+	if err != nil {
+		return nil, err
+	}
+	// validation
+	sender := ToLowerHexWithoutPrefix(contract.Caller())
+	if sender != msg.Signer.Account {
+		return nil, fmt.Errorf(ErrInvalidSender, sender, msg.Signer.Account)
+	}
+	// the signature check is metered by the gas schedule
+	d.dasignersKeeper.ConsumePairingGas(ctx)
+	// execute
+	_, err = d.dasignersKeeper.RegisterSigner(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitNewSignerEvent(ctx, stateDB, args[0].(IDASignersSignerDetail))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (d *DASignersPrecompile) AuthorizeSigner(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgAuthorizeSigner(args, ToLowerHexWithoutPrefix(contract.Caller()))
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.dasignersKeeper.AuthorizeSigner(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSignerAuthorizedEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), common.HexToAddress(response.PreviousSigner))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
@@ -27,6 +27,20 @@ type IDASignersSignerDetail = struct {
 	PkG2   BN254G2Point   "json:\"pkG2\""
 }
 
+type IDASignersParams = struct {
+	TokensPerVote          *big.Int "json:\"tokensPerVote\""
+	MaxVotesPerSigner      *big.Int "json:\"maxVotesPerSigner\""
+	MaxQuorums             *big.Int "json:\"maxQuorums\""
+	EpochBlocks            *big.Int "json:\"epochBlocks\""
+	EncodedSlices          *big.Int "json:\"encodedSlices\""
+	ExitCooldownEpochs     *big.Int "json:\"exitCooldownEpochs\""
+	SlashFractionBps       *big.Int "json:\"slashFractionBps\""
+	HistoryRetentionEpochs *big.Int "json:\"historyRetentionEpochs\""
+	RewardFeeShareBps      *big.Int "json:\"rewardFeeShareBps\""
+	MinQuorumSigners       *big.Int "json:\"minQuorumSigners\""
+	MaxSignerShareBps      *big.Int "json:\"maxSignerShareBps\""
+}
+
 func NewBN254G1Point(b []byte) BN254G1Point {
 	return BN254G1Point{
 		X: new(big.Int).SetBytes(b[:32]),
@@ -122,6 +136,67 @@ func NewQueryAggregatePubkeyG1Request(args []interface{}) (*dasignerstypes.Query
 	}, nil
 }
 
+func NewQueryVerifyAggregateSignatureRequest(args []interface{}) (*dasignerstypes.QueryVerifyAggregateSignatureRequest, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	msgHash := args[3].([32]byte)
+	return &dasignerstypes.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        args[0].(*big.Int).Uint64(),
+		QuorumId:           args[1].(*big.Int).Uint64(),
+		QuorumBitmap:       args[2].([]byte),
+		MessageHash:        msgHash[:],
+		AggregateSignature: SerializeG1(args[4].(BN254G1Point)),
+	}, nil
+}
+
+func NewQueryPendingRewardsRequest(args []interface{}) (*dasignerstypes.QueryPendingRewardsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &dasignerstypes.QueryPendingRewardsRequest{
+		Account: ToLowerHexWithoutPrefix(args[0].(common.Address)),
+	}, nil
+}
+
+func NewQueryEpochParamsRequest(args []interface{}) (*dasignerstypes.QueryEpochParamsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &dasignerstypes.QueryEpochParamsRequest{
+		EpochNumber: args[0].(*big.Int).Uint64(),
+	}, nil
+}
+
+func NewQuerySignerOperatorRequest(args []interface{}) (*dasignerstypes.QuerySignerOperatorRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &dasignerstypes.QuerySignerOperatorRequest{
+		Account: ToLowerHexWithoutPrefix(args[0].(common.Address)),
+	}, nil
+}
+
+func NewIDASignersParams(params dasignerstypes.Params) IDASignersParams {
+	return IDASignersParams{
+		TokensPerVote:          new(big.Int).SetUint64(params.TokensPerVote),
+		MaxVotesPerSigner:      new(big.Int).SetUint64(params.MaxVotesPerSigner),
+		MaxQuorums:             new(big.Int).SetUint64(params.MaxQuorums),
+		EpochBlocks:            new(big.Int).SetUint64(params.EpochBlocks),
+		EncodedSlices:          new(big.Int).SetUint64(params.EncodedSlices),
+		ExitCooldownEpochs:     new(big.Int).SetUint64(params.ExitCooldownEpochs),
+		SlashFractionBps:       new(big.Int).SetUint64(params.SlashFractionBps),
+		HistoryRetentionEpochs: new(big.Int).SetUint64(params.HistoryRetentionEpochs),
+		RewardFeeShareBps:      new(big.Int).SetUint64(params.RewardFeeShareBps),
+		MinQuorumSigners:       new(big.Int).SetUint64(params.MinQuorumSigners),
+		MaxSignerShareBps:      new(big.Int).SetUint64(params.MaxSignerShareBps),
+	}
+}
+
 func NewIDASignersSignerDetail(signer *dasignerstypes.Signer) IDASignersSignerDetail {
 	return IDASignersSignerDetail{
 		Signer: common.HexToAddress(signer.Account),
@@ -173,3 +248,60 @@ func NewMsgUpdateSocket(args []interface{}, account string) (*dasignerstypes.Msg
 		Socket:  args[0].(string),
 	}, nil
 }
+
+func NewMsgDeregisterSigner(args []interface{}, account string) (*dasignerstypes.MsgDeregisterSigner, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return &dasignerstypes.MsgDeregisterSigner{
+		Account: account,
+	}, nil
+}
+
+func NewMsgRotateSignerKey(args []interface{}, account string) (*dasignerstypes.MsgRotateSignerKey, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	return &dasignerstypes.MsgRotateSignerKey{
+		Account:   account,
+		PubkeyG1:  SerializeG1(args[0].(BN254G1Point)),
+		PubkeyG2:  SerializeG2(args[1].(BN254G2Point)),
+		Signature: SerializeG1(args[2].(BN254G1Point)),
+	}, nil
+}
+
+func NewMsgClaimRewards(args []interface{}, account string) (*dasignerstypes.MsgClaimRewards, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return &dasignerstypes.MsgClaimRewards{
+		Account: account,
+	}, nil
+}
+
+func NewMsgRegisterOperatedSigner(args []interface{}) (*dasignerstypes.MsgRegisterSigner, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	msg, err := NewMsgRegisterSigner(args[:2])
+	if err != nil {
+		return nil, err
+	}
+	msg.Operator = ToLowerHexWithoutPrefix(args[2].(common.Address))
+	return msg, nil
+}
+
+func NewMsgAuthorizeSigner(args []interface{}, operator string) (*dasignerstypes.MsgAuthorizeSigner, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &dasignerstypes.MsgAuthorizeSigner{
+		Operator: operator,
+		Signer:   ToLowerHexWithoutPrefix(args[0].(common.Address)),
+	}, nil
+}
@@ -0,0 +1,811 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package distribution
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DistributionMetaData contains all meta data concerning the Distribution contract.
+var DistributionMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"withdrawAddress\",\"type\":\"address\"}],\"name\":\"SetWithdrawAddress\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawDelegatorRewards\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"amount\",\"type\":\"tuple[]\"}],\"name\":\"WithdrawValidatorCommission\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"delegationRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"}],\"name\":\"delegationTotalRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_withdrawAddress\",\"type\":\"address\"}],\"name\":\"setWithdrawAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"validatorCommission\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"}],\"name\":\"withdrawAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"withdrawDelegatorRewards\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawValidatorCommission\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIDistribution.Coin[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// DistributionABI is the input ABI used to generate the binding from.
+// Deprecated: Use DistributionMetaData.ABI instead.
+var DistributionABI = DistributionMetaData.ABI
+
+// Distribution is an auto generated Go binding around an Ethereum contract.
+type Distribution struct {
+	DistributionCaller     // Read-only binding to the contract
+	DistributionTransactor // Write-only binding to the contract
+	DistributionFilterer   // Log filterer for contract events
+}
+
+// DistributionCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DistributionCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DistributionTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DistributionTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DistributionFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DistributionFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DistributionSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DistributionSession struct {
+	Contract     *Distribution     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DistributionCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DistributionCallerSession struct {
+	Contract *DistributionCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// DistributionTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DistributionTransactorSession struct {
+	Contract     *DistributionTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// DistributionRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DistributionRaw struct {
+	Contract *Distribution // Generic contract binding to access the raw methods on
+}
+
+// DistributionCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DistributionCallerRaw struct {
+	Contract *DistributionCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DistributionTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DistributionTransactorRaw struct {
+	Contract *DistributionTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDistribution creates a new instance of Distribution, bound to a specific deployed contract.
+func NewDistribution(address common.Address, backend bind.ContractBackend) (*Distribution, error) {
+	contract, err := bindDistribution(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Distribution{DistributionCaller: DistributionCaller{contract: contract}, DistributionTransactor: DistributionTransactor{contract: contract}, DistributionFilterer: DistributionFilterer{contract: contract}}, nil
+}
+
+// NewDistributionCaller creates a new read-only instance of Distribution, bound to a specific deployed contract.
+func NewDistributionCaller(address common.Address, caller bind.ContractCaller) (*DistributionCaller, error) {
+	contract, err := bindDistribution(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionCaller{contract: contract}, nil
+}
+
+// NewDistributionTransactor creates a new write-only instance of Distribution, bound to a specific deployed contract.
+func NewDistributionTransactor(address common.Address, transactor bind.ContractTransactor) (*DistributionTransactor, error) {
+	contract, err := bindDistribution(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionTransactor{contract: contract}, nil
+}
+
+// NewDistributionFilterer creates a new log filterer instance of Distribution, bound to a specific deployed contract.
+func NewDistributionFilterer(address common.Address, filterer bind.ContractFilterer) (*DistributionFilterer, error) {
+	contract, err := bindDistribution(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionFilterer{contract: contract}, nil
+}
+
+// bindDistribution binds a generic wrapper to an already deployed contract.
+func bindDistribution(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DistributionABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Distribution *DistributionRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Distribution.Contract.DistributionCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Distribution *DistributionRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Distribution.Contract.DistributionTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Distribution *DistributionRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Distribution.Contract.DistributionTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Distribution *DistributionCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Distribution.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Distribution *DistributionTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Distribution.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Distribution *DistributionTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Distribution.Contract.contract.Transact(opts, method, params...)
+}
+
+// DelegationRewards is a free data retrieval call binding the contract method 0xc9a21b7b.
+//
+// Solidity: function delegationRewards(address _delegator, address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionCaller) DelegationRewards(opts *bind.CallOpts, _delegator common.Address, _validator common.Address) ([]IDistributionCoin, error) {
+	var out []interface{}
+	err := _Distribution.contract.Call(opts, &out, "delegationRewards", _delegator, _validator)
+
+	if err != nil {
+		return *new([]IDistributionCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IDistributionCoin)).(*[]IDistributionCoin)
+
+	return out0, err
+
+}
+
+// DelegationRewards is a free data retrieval call binding the contract method 0xc9a21b7b.
+//
+// Solidity: function delegationRewards(address _delegator, address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionSession) DelegationRewards(_delegator common.Address, _validator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.DelegationRewards(&_Distribution.CallOpts, _delegator, _validator)
+}
+
+// DelegationRewards is a free data retrieval call binding the contract method 0xc9a21b7b.
+//
+// Solidity: function delegationRewards(address _delegator, address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionCallerSession) DelegationRewards(_delegator common.Address, _validator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.DelegationRewards(&_Distribution.CallOpts, _delegator, _validator)
+}
+
+// DelegationTotalRewards is a free data retrieval call binding the contract method 0x54be1a28.
+//
+// Solidity: function delegationTotalRewards(address _delegator) view returns((string,uint256)[])
+func (_Distribution *DistributionCaller) DelegationTotalRewards(opts *bind.CallOpts, _delegator common.Address) ([]IDistributionCoin, error) {
+	var out []interface{}
+	err := _Distribution.contract.Call(opts, &out, "delegationTotalRewards", _delegator)
+
+	if err != nil {
+		return *new([]IDistributionCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IDistributionCoin)).(*[]IDistributionCoin)
+
+	return out0, err
+
+}
+
+// DelegationTotalRewards is a free data retrieval call binding the contract method 0x54be1a28.
+//
+// Solidity: function delegationTotalRewards(address _delegator) view returns((string,uint256)[])
+func (_Distribution *DistributionSession) DelegationTotalRewards(_delegator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.DelegationTotalRewards(&_Distribution.CallOpts, _delegator)
+}
+
+// DelegationTotalRewards is a free data retrieval call binding the contract method 0x54be1a28.
+//
+// Solidity: function delegationTotalRewards(address _delegator) view returns((string,uint256)[])
+func (_Distribution *DistributionCallerSession) DelegationTotalRewards(_delegator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.DelegationTotalRewards(&_Distribution.CallOpts, _delegator)
+}
+
+// ValidatorCommission is a free data retrieval call binding the contract method 0x83a25078.
+//
+// Solidity: function validatorCommission(address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionCaller) ValidatorCommission(opts *bind.CallOpts, _validator common.Address) ([]IDistributionCoin, error) {
+	var out []interface{}
+	err := _Distribution.contract.Call(opts, &out, "validatorCommission", _validator)
+
+	if err != nil {
+		return *new([]IDistributionCoin), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IDistributionCoin)).(*[]IDistributionCoin)
+
+	return out0, err
+
+}
+
+// ValidatorCommission is a free data retrieval call binding the contract method 0x83a25078.
+//
+// Solidity: function validatorCommission(address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionSession) ValidatorCommission(_validator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.ValidatorCommission(&_Distribution.CallOpts, _validator)
+}
+
+// ValidatorCommission is a free data retrieval call binding the contract method 0x83a25078.
+//
+// Solidity: function validatorCommission(address _validator) view returns((string,uint256)[])
+func (_Distribution *DistributionCallerSession) ValidatorCommission(_validator common.Address) ([]IDistributionCoin, error) {
+	return _Distribution.Contract.ValidatorCommission(&_Distribution.CallOpts, _validator)
+}
+
+// WithdrawAddress is a free data retrieval call binding the contract method 0xda16ff04.
+//
+// Solidity: function withdrawAddress(address _delegator) view returns(address)
+func (_Distribution *DistributionCaller) WithdrawAddress(opts *bind.CallOpts, _delegator common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Distribution.contract.Call(opts, &out, "withdrawAddress", _delegator)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WithdrawAddress is a free data retrieval call binding the contract method 0xda16ff04.
+//
+// Solidity: function withdrawAddress(address _delegator) view returns(address)
+func (_Distribution *DistributionSession) WithdrawAddress(_delegator common.Address) (common.Address, error) {
+	return _Distribution.Contract.WithdrawAddress(&_Distribution.CallOpts, _delegator)
+}
+
+// WithdrawAddress is a free data retrieval call binding the contract method 0xda16ff04.
+//
+// Solidity: function withdrawAddress(address _delegator) view returns(address)
+func (_Distribution *DistributionCallerSession) WithdrawAddress(_delegator common.Address) (common.Address, error) {
+	return _Distribution.Contract.WithdrawAddress(&_Distribution.CallOpts, _delegator)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0x3ab1a494.
+//
+// Solidity: function setWithdrawAddress(address _withdrawAddress) returns()
+func (_Distribution *DistributionTransactor) SetWithdrawAddress(opts *bind.TransactOpts, _withdrawAddress common.Address) (*types.Transaction, error) {
+	return _Distribution.contract.Transact(opts, "setWithdrawAddress", _withdrawAddress)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0x3ab1a494.
+//
+// Solidity: function setWithdrawAddress(address _withdrawAddress) returns()
+func (_Distribution *DistributionSession) SetWithdrawAddress(_withdrawAddress common.Address) (*types.Transaction, error) {
+	return _Distribution.Contract.SetWithdrawAddress(&_Distribution.TransactOpts, _withdrawAddress)
+}
+
+// SetWithdrawAddress is a paid mutator transaction binding the contract method 0x3ab1a494.
+//
+// Solidity: function setWithdrawAddress(address _withdrawAddress) returns()
+func (_Distribution *DistributionTransactorSession) SetWithdrawAddress(_withdrawAddress common.Address) (*types.Transaction, error) {
+	return _Distribution.Contract.SetWithdrawAddress(&_Distribution.TransactOpts, _withdrawAddress)
+}
+
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0x1095cf98.
+//
+// Solidity: function withdrawDelegatorRewards(address _validator) returns((string,uint256)[])
+func (_Distribution *DistributionTransactor) WithdrawDelegatorRewards(opts *bind.TransactOpts, _validator common.Address) (*types.Transaction, error) {
+	return _Distribution.contract.Transact(opts, "withdrawDelegatorRewards", _validator)
+}
+
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0x1095cf98.
+//
+// Solidity: function withdrawDelegatorRewards(address _validator) returns((string,uint256)[])
+func (_Distribution *DistributionSession) WithdrawDelegatorRewards(_validator common.Address) (*types.Transaction, error) {
+	return _Distribution.Contract.WithdrawDelegatorRewards(&_Distribution.TransactOpts, _validator)
+}
+
+// WithdrawDelegatorRewards is a paid mutator transaction binding the contract method 0x1095cf98.
+//
+// Solidity: function withdrawDelegatorRewards(address _validator) returns((string,uint256)[])
+func (_Distribution *DistributionTransactorSession) WithdrawDelegatorRewards(_validator common.Address) (*types.Transaction, error) {
+	return _Distribution.Contract.WithdrawDelegatorRewards(&_Distribution.TransactOpts, _validator)
+}
+
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((string,uint256)[])
+func (_Distribution *DistributionTransactor) WithdrawValidatorCommission(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Distribution.contract.Transact(opts, "withdrawValidatorCommission")
+}
+
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((string,uint256)[])
+func (_Distribution *DistributionSession) WithdrawValidatorCommission() (*types.Transaction, error) {
+	return _Distribution.Contract.WithdrawValidatorCommission(&_Distribution.TransactOpts)
+}
+
+// WithdrawValidatorCommission is a paid mutator transaction binding the contract method 0x0bde076d.
+//
+// Solidity: function withdrawValidatorCommission() returns((string,uint256)[])
+func (_Distribution *DistributionTransactorSession) WithdrawValidatorCommission() (*types.Transaction, error) {
+	return _Distribution.Contract.WithdrawValidatorCommission(&_Distribution.TransactOpts)
+}
+
+// DistributionSetWithdrawAddressIterator is returned from FilterSetWithdrawAddress and is used to iterate over the raw logs and unpacked data for SetWithdrawAddress events raised by the Distribution contract.
+type DistributionSetWithdrawAddressIterator struct {
+	Event *DistributionSetWithdrawAddress // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DistributionSetWithdrawAddressIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DistributionSetWithdrawAddress)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DistributionSetWithdrawAddress)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DistributionSetWithdrawAddressIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DistributionSetWithdrawAddressIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DistributionSetWithdrawAddress represents a SetWithdrawAddress event raised by the Distribution contract.
+type DistributionSetWithdrawAddress struct {
+	Delegator       common.Address
+	WithdrawAddress common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetWithdrawAddress is a free log retrieval operation binding the contract event 0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c.
+//
+// Solidity: event SetWithdrawAddress(address indexed delegator, address withdrawAddress)
+func (_Distribution *DistributionFilterer) FilterSetWithdrawAddress(opts *bind.FilterOpts, delegator []common.Address) (*DistributionSetWithdrawAddressIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.FilterLogs(opts, "SetWithdrawAddress", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionSetWithdrawAddressIterator{contract: _Distribution.contract, event: "SetWithdrawAddress", logs: logs, sub: sub}, nil
+}
+
+// WatchSetWithdrawAddress is a free log subscription operation binding the contract event 0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c.
+//
+// Solidity: event SetWithdrawAddress(address indexed delegator, address withdrawAddress)
+func (_Distribution *DistributionFilterer) WatchSetWithdrawAddress(opts *bind.WatchOpts, sink chan<- *DistributionSetWithdrawAddress, delegator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.WatchLogs(opts, "SetWithdrawAddress", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DistributionSetWithdrawAddress)
+				if err := _Distribution.contract.UnpackLog(event, "SetWithdrawAddress", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetWithdrawAddress is a log parse operation binding the contract event 0xae416f064415339eb2fc98ef48a0fc06ee07e3b33d469e001c477eac6e68947c.
+//
+// Solidity: event SetWithdrawAddress(address indexed delegator, address withdrawAddress)
+func (_Distribution *DistributionFilterer) ParseSetWithdrawAddress(log types.Log) (*DistributionSetWithdrawAddress, error) {
+	event := new(DistributionSetWithdrawAddress)
+	if err := _Distribution.contract.UnpackLog(event, "SetWithdrawAddress", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DistributionWithdrawDelegatorRewardsIterator is returned from FilterWithdrawDelegatorRewards and is used to iterate over the raw logs and unpacked data for WithdrawDelegatorRewards events raised by the Distribution contract.
+type DistributionWithdrawDelegatorRewardsIterator struct {
+	Event *DistributionWithdrawDelegatorRewards // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DistributionWithdrawDelegatorRewardsIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DistributionWithdrawDelegatorRewards)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DistributionWithdrawDelegatorRewards)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DistributionWithdrawDelegatorRewardsIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DistributionWithdrawDelegatorRewardsIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DistributionWithdrawDelegatorRewards represents a WithdrawDelegatorRewards event raised by the Distribution contract.
+type DistributionWithdrawDelegatorRewards struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    []IDistributionCoin
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawDelegatorRewards is a free log retrieval operation binding the contract event 0xb6d08e3e0434575b7c45cccf7d5568eb38b4b8e8f9eb7810da3a8d0ea7442dae.
+//
+// Solidity: event WithdrawDelegatorRewards(address indexed delegator, address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) FilterWithdrawDelegatorRewards(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*DistributionWithdrawDelegatorRewardsIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.FilterLogs(opts, "WithdrawDelegatorRewards", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionWithdrawDelegatorRewardsIterator{contract: _Distribution.contract, event: "WithdrawDelegatorRewards", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawDelegatorRewards is a free log subscription operation binding the contract event 0xb6d08e3e0434575b7c45cccf7d5568eb38b4b8e8f9eb7810da3a8d0ea7442dae.
+//
+// Solidity: event WithdrawDelegatorRewards(address indexed delegator, address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) WatchWithdrawDelegatorRewards(opts *bind.WatchOpts, sink chan<- *DistributionWithdrawDelegatorRewards, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.WatchLogs(opts, "WithdrawDelegatorRewards", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DistributionWithdrawDelegatorRewards)
+				if err := _Distribution.contract.UnpackLog(event, "WithdrawDelegatorRewards", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawDelegatorRewards is a log parse operation binding the contract event 0xb6d08e3e0434575b7c45cccf7d5568eb38b4b8e8f9eb7810da3a8d0ea7442dae.
+//
+// Solidity: event WithdrawDelegatorRewards(address indexed delegator, address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) ParseWithdrawDelegatorRewards(log types.Log) (*DistributionWithdrawDelegatorRewards, error) {
+	event := new(DistributionWithdrawDelegatorRewards)
+	if err := _Distribution.contract.UnpackLog(event, "WithdrawDelegatorRewards", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DistributionWithdrawValidatorCommissionIterator is returned from FilterWithdrawValidatorCommission and is used to iterate over the raw logs and unpacked data for WithdrawValidatorCommission events raised by the Distribution contract.
+type DistributionWithdrawValidatorCommissionIterator struct {
+	Event *DistributionWithdrawValidatorCommission // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DistributionWithdrawValidatorCommissionIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DistributionWithdrawValidatorCommission)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DistributionWithdrawValidatorCommission)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DistributionWithdrawValidatorCommissionIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DistributionWithdrawValidatorCommissionIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DistributionWithdrawValidatorCommission represents a WithdrawValidatorCommission event raised by the Distribution contract.
+type DistributionWithdrawValidatorCommission struct {
+	Validator common.Address
+	Amount    []IDistributionCoin
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawValidatorCommission is a free log retrieval operation binding the contract event 0x5a9443499c613bba22995c5b4924a51a582011bee8315b65a762d67497bc8592.
+//
+// Solidity: event WithdrawValidatorCommission(address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) FilterWithdrawValidatorCommission(opts *bind.FilterOpts, validator []common.Address) (*DistributionWithdrawValidatorCommissionIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.FilterLogs(opts, "WithdrawValidatorCommission", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionWithdrawValidatorCommissionIterator{contract: _Distribution.contract, event: "WithdrawValidatorCommission", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawValidatorCommission is a free log subscription operation binding the contract event 0x5a9443499c613bba22995c5b4924a51a582011bee8315b65a762d67497bc8592.
+//
+// Solidity: event WithdrawValidatorCommission(address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) WatchWithdrawValidatorCommission(opts *bind.WatchOpts, sink chan<- *DistributionWithdrawValidatorCommission, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Distribution.contract.WatchLogs(opts, "WithdrawValidatorCommission", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DistributionWithdrawValidatorCommission)
+				if err := _Distribution.contract.UnpackLog(event, "WithdrawValidatorCommission", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawValidatorCommission is a log parse operation binding the contract event 0x5a9443499c613bba22995c5b4924a51a582011bee8315b65a762d67497bc8592.
+//
+// Solidity: event WithdrawValidatorCommission(address indexed validator, (string,uint256)[] amount)
+func (_Distribution *DistributionFilterer) ParseWithdrawValidatorCommission(log types.Log) (*DistributionWithdrawValidatorCommission, error) {
+	event := new(DistributionWithdrawValidatorCommission)
+	if err := _Distribution.contract.UnpackLog(event, "WithdrawValidatorCommission", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,90 @@
+package distribution
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001002"
+
+	DistributionFunctionWithdrawDelegatorRewards    = "withdrawDelegatorRewards"
+	DistributionFunctionWithdrawValidatorCommission = "withdrawValidatorCommission"
+	DistributionFunctionSetWithdrawAddress          = "setWithdrawAddress"
+	DistributionFunctionDelegationRewards           = "delegationRewards"
+	DistributionFunctionDelegationTotalRewards      = "delegationTotalRewards"
+	DistributionFunctionValidatorCommission         = "validatorCommission"
+	DistributionFunctionWithdrawAddress             = "withdrawAddress"
+)
+
+var RequiredGasBasic = map[string]uint64{
+	DistributionFunctionWithdrawDelegatorRewards:    150000,
+	DistributionFunctionWithdrawValidatorCommission: 100000,
+	DistributionFunctionSetWithdrawAddress:          50000,
+	DistributionFunctionDelegationRewards:           30000,
+	DistributionFunctionDelegationTotalRewards:      100000,
+	DistributionFunctionValidatorCommission:         10000,
+	DistributionFunctionWithdrawAddress:             10000,
+}
+
+var _ vm.PrecompiledContract = &DistributionPrecompile{}
+
+type DistributionPrecompile struct {
+	precopmiles_common.PrecompileBase
+	distrKeeper distrkeeper.Keeper
+}
+
+func NewDistributionPrecompile(distrKeeper distrkeeper.Keeper) (*DistributionPrecompile, error) {
+	base, err := precopmiles_common.NewPrecompileBase(DistributionABI, RequiredGasBasic)
+	if err != nil {
+		return nil, err
+	}
+	return &DistributionPrecompile{
+		PrecompileBase: base,
+		distrKeeper:    distrKeeper,
+	}, nil
+}
+
+// Address implements vm.PrecompiledContract.
+func (d *DistributionPrecompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// Run implements vm.PrecompiledContract.
+func (d *DistributionPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return d.Execute(evm, contract, readonly, func(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+		switch method.Name {
+		// queries
+		case DistributionFunctionDelegationRewards:
+			return d.DelegationRewards(ctx, evm, method, args)
+		case DistributionFunctionDelegationTotalRewards:
+			return d.DelegationTotalRewards(ctx, evm, method, args)
+		case DistributionFunctionValidatorCommission:
+			return d.ValidatorCommission(ctx, evm, method, args)
+		case DistributionFunctionWithdrawAddress:
+			return d.WithdrawAddress(ctx, evm, method, args)
+		// txs
+		case DistributionFunctionWithdrawDelegatorRewards:
+			return d.WithdrawDelegatorRewards(ctx, contract, stateDB, method, args)
+		case DistributionFunctionWithdrawValidatorCommission:
+			return d.WithdrawValidatorCommission(ctx, contract, stateDB, method, args)
+		case DistributionFunctionSetWithdrawAddress:
+			return d.SetWithdrawAddress(ctx, contract, stateDB, method, args)
+		}
+		return nil, vm.ErrExecutionReverted
+	})
+}
+
+func (d *DistributionPrecompile) msgServer() distrtypes.MsgServer {
+	return distrkeeper.NewMsgServerImpl(d.distrKeeper)
+}
+
+func (d *DistributionPrecompile) querier() distrtypes.QueryServer {
+	return distrkeeper.NewQuerier(d.distrKeeper)
+}
@@ -0,0 +1,281 @@
+package distribution_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+	distributionprecompile "github.com/0glabs/0g-chain/precompiles/distribution"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/suite"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+type DistributionTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	abi          abi.ABI
+	addr         common.Address
+	distribution *distributionprecompile.DistributionPrecompile
+	signerOne    *testutil.TestSigner
+	validatorOne *testutil.TestSigner
+}
+
+func (suite *DistributionTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+
+	suite.addr = common.HexToAddress(distributionprecompile.PrecompileAddress)
+
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	precompile, ok := precompiles[suite.addr]
+	suite.Assert().EqualValues(ok, true)
+	suite.distribution = precompile.(*distributionprecompile.DistributionPrecompile)
+
+	suite.signerOne = testutil.GenSigner()
+	suite.validatorOne = testutil.GenSigner()
+	abi, err := abi.JSON(strings.NewReader(distributionprecompile.DistributionABI))
+	suite.Assert().NoError(err)
+	suite.abi = abi
+}
+
+func (suite *DistributionTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
+	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), big.NewInt(0), gas)
+	contract.Input = input
+
+	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
+	msgEthereumTx.From = signer.HexAddr
+	err := msgEthereumTx.Sign(suite.EthSigner, signer.Signer)
+	suite.Assert().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+	cfg, err := suite.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, suite.EvmKeeper.ChainID())
+	suite.Assert().NoError(err, "failed to instantiate EVM config")
+
+	msg, err := msgEthereumTx.AsMessage(suite.EthSigner, big.NewInt(0))
+	suite.Assert().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := suite.EvmKeeper.NewEVM(suite.Ctx, msg, cfg, nil, suite.Statedb)
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	evm.WithPrecompiles(precompiles, []common.Address{suite.addr})
+
+	return suite.distribution.Run(evm, contract, false)
+}
+
+// setupRewards delegates from the delegator to a validator with 10% commission and allocates rewards to the validator
+func (suite *DistributionTestSuite) setupRewards(delegator common.Address, operator common.Address, amount int64) {
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	valAddr := sdk.ValAddress(operator.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.NewDecWithPrec(1, 1))
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	// SetValidator bypasses the staking hooks, distribution tracks the validator from its creation
+	err = suite.StakingKeeper.Hooks().AfterValidatorCreated(suite.Ctx, valAddr)
+	suite.Require().NoError(err)
+
+	delAddr := sdk.AccAddress(delegator.Bytes())
+	err = suite.App.FundAccount(suite.Ctx, delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+	suite.Require().NoError(err)
+	_, err = suite.StakingKeeper.Delegate(suite.Ctx, delAddr, sdk.NewInt(1000), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount))
+	err = suite.App.FundModuleAccount(suite.Ctx, distrtypes.ModuleName, rewards)
+	suite.Require().NoError(err)
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.App.GetDistrKeeper().AllocateTokensToValidator(suite.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+	// delegations earn nothing in the block they are created
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+	suite.Statedb = statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
+}
+
+func (suite *DistributionTestSuite) withdrawDelegatorRewards(testSigner *testutil.TestSigner, validator common.Address) []distributionprecompile.IDistributionCoin {
+	input, err := suite.abi.Pack(
+		"withdrawDelegatorRewards",
+		validator,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["withdrawDelegatorRewards"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	event, err := suite.abi.Unpack("WithdrawDelegatorRewards", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], event[0])
+	return out[0].([]distributionprecompile.IDistributionCoin)
+}
+
+func (suite *DistributionTestSuite) withdrawValidatorCommission(testSigner *testutil.TestSigner) []distributionprecompile.IDistributionCoin {
+	input, err := suite.abi.Pack(
+		"withdrawValidatorCommission",
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["withdrawValidatorCommission"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	event, err := suite.abi.Unpack("WithdrawValidatorCommission", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(out[0], event[0])
+	return out[0].([]distributionprecompile.IDistributionCoin)
+}
+
+func (suite *DistributionTestSuite) setWithdrawAddress(testSigner *testutil.TestSigner, withdrawAddress common.Address) {
+	input, err := suite.abi.Pack(
+		"setWithdrawAddress",
+		withdrawAddress,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("SetWithdrawAddress", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(withdrawAddress, out[0])
+}
+
+func (suite *DistributionTestSuite) queryCoins(testSigner *testutil.TestSigner, method string, args ...interface{}) []distributionprecompile.IDistributionCoin {
+	input, err := suite.abi.Pack(method, args...)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods[method].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].([]distributionprecompile.IDistributionCoin)
+}
+
+func (suite *DistributionTestSuite) queryWithdrawAddress(testSigner *testutil.TestSigner, delegator common.Address) common.Address {
+	input, err := suite.abi.Pack(
+		"withdrawAddress",
+		delegator,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["withdrawAddress"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(common.Address)
+}
+
+func (suite *DistributionTestSuite) Test_Distribution() {
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	suite.setupRewards(suite.signerOne.Addr, suite.validatorOne.Addr, 1000)
+	rewards := []distributionprecompile.IDistributionCoin{{Denom: bondDenom, Amount: big.NewInt(900)}}
+	commission := []distributionprecompile.IDistributionCoin{{Denom: bondDenom, Amount: big.NewInt(100)}}
+
+	// queries
+	suite.Assert().EqualValues(rewards, suite.queryCoins(suite.signerOne, "delegationRewards", suite.signerOne.Addr, suite.validatorOne.Addr))
+	suite.Assert().EqualValues(rewards, suite.queryCoins(suite.signerOne, "delegationTotalRewards", suite.signerOne.Addr))
+	suite.Assert().EqualValues(commission, suite.queryCoins(suite.signerOne, "validatorCommission", suite.validatorOne.Addr))
+	suite.Assert().EqualValues(suite.signerOne.Addr, suite.queryWithdrawAddress(suite.signerOne, suite.signerOne.Addr))
+
+	// withdraw rewards to another address
+	recipient := testutil.GenSigner().Addr
+	suite.setWithdrawAddress(suite.signerOne, recipient)
+	suite.Assert().EqualValues(recipient, suite.queryWithdrawAddress(suite.signerOne, suite.signerOne.Addr))
+	suite.Assert().EqualValues(rewards, suite.withdrawDelegatorRewards(suite.signerOne, suite.validatorOne.Addr))
+	bankKeeper := suite.App.GetBankKeeper()
+	suite.Assert().EqualValues(sdk.NewInt(900), bankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(recipient.Bytes()), bondDenom).Amount)
+	suite.Assert().Len(suite.queryCoins(suite.signerOne, "delegationRewards", suite.signerOne.Addr, suite.validatorOne.Addr), 0)
+
+	// withdraw commission
+	suite.Assert().EqualValues(commission, suite.withdrawValidatorCommission(suite.validatorOne))
+	suite.Assert().EqualValues(sdk.NewInt(100), bankKeeper.GetBalance(suite.Ctx, sdk.AccAddress(suite.validatorOne.Addr.Bytes()), bondDenom).Amount)
+	_, err := suite.runTx(suite.abi.Methods["withdrawValidatorCommission"].ID, suite.validatorOne, 10000000)
+	suite.Assert().ErrorIs(err, distrtypes.ErrNoValidatorCommission)
+}
+
+func (suite *DistributionTestSuite) Test_WithdrawCommit() {
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	// the delegator and the operator are contracts receiving value in the tx, so the EVM state commits their balances
+	delegator := suite.DeployForwarder(suite.addr)
+	operator := suite.DeployForwarder(suite.addr)
+	suite.setupRewards(delegator, operator, 1000)
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.signerOne.Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	bankKeeper := suite.App.GetBankKeeper()
+	supply := bankKeeper.GetSupply(suite.Ctx, bondDenom)
+	// 10 of the bond denom in the EVM denom
+	value := new(big.Int).Mul(big.NewInt(10), big.NewInt(chaincfg.GasDenomConversionMultiplier))
+
+	input, err := suite.abi.Pack("withdrawDelegatorRewards", operator)
+	suite.Require().NoError(err)
+	res := suite.ApplyMessage(suite.signerOne, delegator, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Assert().EqualValues(sdk.NewInt(910), bankKeeper.GetBalance(suite.Ctx, delegator.Bytes(), bondDenom).Amount)
+
+	res = suite.ApplyMessage(suite.signerOne, operator, value, suite.abi.Methods["withdrawValidatorCommission"].ID, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Assert().EqualValues(sdk.NewInt(110), bankKeeper.GetBalance(suite.Ctx, operator.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(980), bankKeeper.GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
+
+	// a failed withdrawal moves nothing
+	res = suite.ApplyMessage(suite.signerOne, operator, value, suite.abi.Methods["withdrawValidatorCommission"].ID, 10000000)
+	suite.Require().True(res.Failed())
+	suite.Assert().EqualValues(sdk.NewInt(110), bankKeeper.GetBalance(suite.Ctx, operator.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
+}
+
+func (suite *DistributionTestSuite) Test_WithdrawRevert() {
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	// the delegator and the operator receive value in the tx and withdraw in a call frame which reverts afterwards
+	delegator := suite.DeployRevertingCaller(suite.addr)
+	operator := suite.DeployRevertingCaller(suite.addr)
+	suite.setupRewards(delegator, operator, 1000)
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.signerOne.Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	bankKeeper := suite.App.GetBankKeeper()
+	distrKeeper := suite.App.GetDistrKeeper()
+	supply := bankKeeper.GetSupply(suite.Ctx, bondDenom)
+	outstanding := distrKeeper.GetValidatorOutstandingRewards(suite.Ctx, sdk.ValAddress(operator.Bytes()))
+	pool := suite.App.GetModuleAccountBalance(suite.Ctx, distrtypes.ModuleName, bondDenom)
+	value := new(big.Int).Mul(big.NewInt(10), big.NewInt(chaincfg.GasDenomConversionMultiplier))
+
+	input, err := suite.abi.Pack("withdrawDelegatorRewards", operator)
+	suite.Require().NoError(err)
+	res := suite.ApplyMessage(suite.signerOne, delegator, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	res = suite.ApplyMessage(suite.signerOne, operator, value, suite.abi.Methods["withdrawValidatorCommission"].ID, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+
+	// the withdrawals are reverted with the frames, the rewards stay outstanding and nothing is burnt or minted
+	suite.Assert().EqualValues(sdk.NewInt(10), bankKeeper.GetBalance(suite.Ctx, delegator.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(10), bankKeeper.GetBalance(suite.Ctx, operator.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(980), bankKeeper.GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), bondDenom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, bondDenom))
+	suite.Assert().EqualValues(outstanding, distrKeeper.GetValidatorOutstandingRewards(suite.Ctx, sdk.ValAddress(operator.Bytes())))
+	suite.Assert().EqualValues(pool, suite.App.GetModuleAccountBalance(suite.Ctx, distrtypes.ModuleName, bondDenom))
+}
+
+func TestDistributionSuite(t *testing.T) {
+	suite.Run(t, new(DistributionTestSuite))
+}
@@ -0,0 +1,85 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	WithdrawDelegatorRewardsEvent    = "WithdrawDelegatorRewards"
+	WithdrawValidatorCommissionEvent = "WithdrawValidatorCommission"
+	SetWithdrawAddressEvent          = "SetWithdrawAddress"
+)
+
+func (d *DistributionPrecompile) EmitWithdrawDelegatorRewardsEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator common.Address, amount []IDistributionCoin) error {
+	event := d.ABI.Events[WithdrawDelegatorRewardsEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = delegator
+	quries[2] = validator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2]}
+	b, err := arguments.Pack(amount)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (d *DistributionPrecompile) EmitWithdrawValidatorCommissionEvent(ctx sdk.Context, stateDB *statedb.StateDB, validator common.Address, amount []IDistributionCoin) error {
+	event := d.ABI.Events[WithdrawValidatorCommissionEvent]
+	quries := make([]interface{}, 2)
+	quries[0] = event.ID
+	quries[1] = validator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[1]}
+	b, err := arguments.Pack(amount)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (d *DistributionPrecompile) EmitSetWithdrawAddressEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, withdrawAddress common.Address) error {
+	event := d.ABI.Events[SetWithdrawAddressEvent]
+	quries := make([]interface{}, 2)
+	quries[0] = event.ID
+	quries[1] = delegator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[1]}
+	b, err := arguments.Pack(withdrawAddress)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     d.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,64 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (d *DistributionPrecompile) DelegationRewards(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryDelegationRewardsRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	// the rewards are calculated by closing the current period of the validator, which must not be persisted
+	cacheCtx, _ := ctx.CacheContext()
+	response, err := d.querier().DelegationRewards(sdk.WrapSDKContext(cacheCtx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(NewIDistributionDecCoins(response.Rewards))
+}
+
+func (d *DistributionPrecompile) DelegationTotalRewards(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryDelegationTotalRewardsRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	// the rewards are calculated by closing the current period of the validator, which must not be persisted
+	cacheCtx, _ := ctx.CacheContext()
+	response, err := d.querier().DelegationTotalRewards(sdk.WrapSDKContext(cacheCtx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(NewIDistributionDecCoins(response.Total))
+}
+
+func (d *DistributionPrecompile) ValidatorCommission(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryValidatorCommissionRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.querier().ValidatorCommission(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(NewIDistributionDecCoins(response.Commission.Commission))
+}
+
+func (d *DistributionPrecompile) WithdrawAddress(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	req, err := NewQueryDelegatorWithdrawAddressRequest(args)
+	if err != nil {
+		return nil, err
+	}
+	response, err := d.querier().DelegatorWithdrawAddress(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	withdrawAddress, err := sdk.AccAddressFromBech32(response.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(common.BytesToAddress(withdrawAddress))
+}
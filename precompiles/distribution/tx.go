@@ -0,0 +1,67 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// the delegator of the distribution txs is the immediate caller, so contracts withdraw their own rewards
+
+func (d *DistributionPrecompile) WithdrawDelegatorRewards(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgWithdrawDelegatorReward(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.msgServer().WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	amount := NewIDistributionCoins(response.Amount)
+	err = d.EmitWithdrawDelegatorRewardsEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), amount)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(amount)
+}
+
+func (d *DistributionPrecompile) WithdrawValidatorCommission(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgWithdrawValidatorCommission(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := d.msgServer().WithdrawValidatorCommission(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	amount := NewIDistributionCoins(response.Amount)
+	err = d.EmitWithdrawValidatorCommissionEvent(ctx, stateDB, contract.Caller(), amount)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(amount)
+}
+
+func (d *DistributionPrecompile) SetWithdrawAddress(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgSetWithdrawAddress(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = d.msgServer().SetWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = d.EmitSetWithdrawAddressEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
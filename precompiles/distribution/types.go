@@ -0,0 +1,119 @@
+package distribution
+
+import (
+	"fmt"
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IDistributionCoin = struct {
+	Denom  string   "json:\"denom\""
+	Amount *big.Int "json:\"amount\""
+}
+
+// ToAccAddress converts an EVM address to the account address it controls
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// ToValAddress converts an EVM address to the validator operator address with the same bytes
+func ToValAddress(addr common.Address) sdk.ValAddress {
+	return sdk.ValAddress(addr.Bytes())
+}
+
+func NewIDistributionCoins(coins sdk.Coins) []IDistributionCoin {
+	result := make([]IDistributionCoin, len(coins))
+	for i, coin := range coins {
+		result[i] = IDistributionCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		}
+	}
+	return result
+}
+
+// NewIDistributionDecCoins returns the part of the rewards that can be withdrawn, the fractions stay in the pool
+func NewIDistributionDecCoins(coins sdk.DecCoins) []IDistributionCoin {
+	truncated, _ := coins.TruncateDecimal()
+	return NewIDistributionCoins(truncated)
+}
+
+func NewMsgWithdrawDelegatorReward(args []interface{}, delegator common.Address) (*distrtypes.MsgWithdrawDelegatorReward, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: ToValAddress(args[0].(common.Address)).String(),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func NewMsgWithdrawValidatorCommission(args []interface{}, validator common.Address) (*distrtypes.MsgWithdrawValidatorCommission, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	msg := &distrtypes.MsgWithdrawValidatorCommission{
+		ValidatorAddress: ToValAddress(validator).String(),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func NewMsgSetWithdrawAddress(args []interface{}, delegator common.Address) (*distrtypes.MsgSetWithdrawAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	msg := &distrtypes.MsgSetWithdrawAddress{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		WithdrawAddress:  ToAccAddress(args[0].(common.Address)).String(),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func NewQueryDelegationRewardsRequest(args []interface{}) (*distrtypes.QueryDelegationRewardsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: ToAccAddress(args[0].(common.Address)).String(),
+		ValidatorAddress: ToValAddress(args[1].(common.Address)).String(),
+	}, nil
+}
+
+func NewQueryDelegationTotalRewardsRequest(args []interface{}) (*distrtypes.QueryDelegationTotalRewardsRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &distrtypes.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: ToAccAddress(args[0].(common.Address)).String(),
+	}, nil
+}
+
+func NewQueryValidatorCommissionRequest(args []interface{}) (*distrtypes.QueryValidatorCommissionRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &distrtypes.QueryValidatorCommissionRequest{
+		ValidatorAddress: ToValAddress(args[0].(common.Address)).String(),
+	}, nil
+}
+
+func NewQueryDelegatorWithdrawAddressRequest(args []interface{}) (*distrtypes.QueryDelegatorWithdrawAddressRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return &distrtypes.QueryDelegatorWithdrawAddressRequest{
+		DelegatorAddress: ToAccAddress(args[0].(common.Address)).String(),
+	}, nil
+}
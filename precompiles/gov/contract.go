@@ -0,0 +1,592 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package gov
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// GovMetaData contains all meta data concerning the Gov contract.
+var GovMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"}],\"name\":\"Vote\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint64\",\"name\":\"proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structIGov.WeightedVoteOption[]\",\"name\":\"options\",\"type\":\"tuple[]\"}],\"name\":\"VoteWeighted\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"_proposalId\",\"type\":\"uint64\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"address\",\"name\":\"proposer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"title\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"summary\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"metadata\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"submitTime\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"depositEndTime\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"votingStartTime\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"votingEndTime\",\"type\":\"int64\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"yes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"abstain\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"no\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"noWithVeto\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.TallyResult\",\"name\":\"finalTallyResult\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.Coin[]\",\"name\":\"totalDeposit\",\"type\":\"tuple[]\"}],\"internalType\":\"structIGov.Proposal\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"_proposalId\",\"type\":\"uint64\"}],\"name\":\"getTallyResult\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"yes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"abstain\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"no\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"noWithVeto\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.TallyResult\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"_proposalId\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"_option\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"_metadata\",\"type\":\"string\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"_proposalId\",\"type\":\"uint64\"},{\"components\":[{\"internalType\":\"uint8\",\"name\":\"option\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"weight\",\"type\":\"uint256\"}],\"internalType\":\"structIGov.WeightedVoteOption[]\",\"name\":\"_options\",\"type\":\"tuple[]\"},{\"internalType\":\"string\",\"name\":\"_metadata\",\"type\":\"string\"}],\"name\":\"voteWeighted\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// GovABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovMetaData.ABI instead.
+var GovABI = GovMetaData.ABI
+
+// Gov is an auto generated Go binding around an Ethereum contract.
+type Gov struct {
+	GovCaller     // Read-only binding to the contract
+	GovTransactor // Write-only binding to the contract
+	GovFilterer   // Log filterer for contract events
+}
+
+// GovCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovSession struct {
+	Contract     *Gov              // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovCallerSession struct {
+	Contract *GovCaller    // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// GovTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovTransactorSession struct {
+	Contract     *GovTransactor    // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovRaw struct {
+	Contract *Gov // Generic contract binding to access the raw methods on
+}
+
+// GovCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovCallerRaw struct {
+	Contract *GovCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovTransactorRaw struct {
+	Contract *GovTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGov creates a new instance of Gov, bound to a specific deployed contract.
+func NewGov(address common.Address, backend bind.ContractBackend) (*Gov, error) {
+	contract, err := bindGov(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Gov{GovCaller: GovCaller{contract: contract}, GovTransactor: GovTransactor{contract: contract}, GovFilterer: GovFilterer{contract: contract}}, nil
+}
+
+// NewGovCaller creates a new read-only instance of Gov, bound to a specific deployed contract.
+func NewGovCaller(address common.Address, caller bind.ContractCaller) (*GovCaller, error) {
+	contract, err := bindGov(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovCaller{contract: contract}, nil
+}
+
+// NewGovTransactor creates a new write-only instance of Gov, bound to a specific deployed contract.
+func NewGovTransactor(address common.Address, transactor bind.ContractTransactor) (*GovTransactor, error) {
+	contract, err := bindGov(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovTransactor{contract: contract}, nil
+}
+
+// NewGovFilterer creates a new log filterer instance of Gov, bound to a specific deployed contract.
+func NewGovFilterer(address common.Address, filterer bind.ContractFilterer) (*GovFilterer, error) {
+	contract, err := bindGov(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovFilterer{contract: contract}, nil
+}
+
+// bindGov binds a generic wrapper to an already deployed contract.
+func bindGov(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.GovCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.GovTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Gov *GovCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Gov.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Gov *GovTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Gov *GovTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Gov.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 _proposalId) view returns((uint64,uint8,address,string,string,string,int64,int64,int64,int64,(uint256,uint256,uint256,uint256),(string,uint256)[]))
+func (_Gov *GovCaller) GetProposal(opts *bind.CallOpts, _proposalId uint64) (IGovProposal, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getProposal", _proposalId)
+
+	if err != nil {
+		return *new(IGovProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovProposal)).(*IGovProposal)
+
+	return out0, err
+
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 _proposalId) view returns((uint64,uint8,address,string,string,string,int64,int64,int64,int64,(uint256,uint256,uint256,uint256),(string,uint256)[]))
+func (_Gov *GovSession) GetProposal(_proposalId uint64) (IGovProposal, error) {
+	return _Gov.Contract.GetProposal(&_Gov.CallOpts, _proposalId)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xf1610a28.
+//
+// Solidity: function getProposal(uint64 _proposalId) view returns((uint64,uint8,address,string,string,string,int64,int64,int64,int64,(uint256,uint256,uint256,uint256),(string,uint256)[]))
+func (_Gov *GovCallerSession) GetProposal(_proposalId uint64) (IGovProposal, error) {
+	return _Gov.Contract.GetProposal(&_Gov.CallOpts, _proposalId)
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 _proposalId) view returns((uint256,uint256,uint256,uint256))
+func (_Gov *GovCaller) GetTallyResult(opts *bind.CallOpts, _proposalId uint64) (IGovTallyResult, error) {
+	var out []interface{}
+	err := _Gov.contract.Call(opts, &out, "getTallyResult", _proposalId)
+
+	if err != nil {
+		return *new(IGovTallyResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IGovTallyResult)).(*IGovTallyResult)
+
+	return out0, err
+
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 _proposalId) view returns((uint256,uint256,uint256,uint256))
+func (_Gov *GovSession) GetTallyResult(_proposalId uint64) (IGovTallyResult, error) {
+	return _Gov.Contract.GetTallyResult(&_Gov.CallOpts, _proposalId)
+}
+
+// GetTallyResult is a free data retrieval call binding the contract method 0xba66a648.
+//
+// Solidity: function getTallyResult(uint64 _proposalId) view returns((uint256,uint256,uint256,uint256))
+func (_Gov *GovCallerSession) GetTallyResult(_proposalId uint64) (IGovTallyResult, error) {
+	return _Gov.Contract.GetTallyResult(&_Gov.CallOpts, _proposalId)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 _proposalId, uint8 _option, string _metadata) returns()
+func (_Gov *GovTransactor) Vote(opts *bind.TransactOpts, _proposalId uint64, _option uint8, _metadata string) (*types.Transaction, error) {
+	return _Gov.contract.Transact(opts, "vote", _proposalId, _option, _metadata)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 _proposalId, uint8 _option, string _metadata) returns()
+func (_Gov *GovSession) Vote(_proposalId uint64, _option uint8, _metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.Vote(&_Gov.TransactOpts, _proposalId, _option, _metadata)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x528783d5.
+//
+// Solidity: function vote(uint64 _proposalId, uint8 _option, string _metadata) returns()
+func (_Gov *GovTransactorSession) Vote(_proposalId uint64, _option uint8, _metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.Vote(&_Gov.TransactOpts, _proposalId, _option, _metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 _proposalId, (uint8,uint256)[] _options, string _metadata) returns()
+func (_Gov *GovTransactor) VoteWeighted(opts *bind.TransactOpts, _proposalId uint64, _options []IGovWeightedVoteOption, _metadata string) (*types.Transaction, error) {
+	return _Gov.contract.Transact(opts, "voteWeighted", _proposalId, _options, _metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 _proposalId, (uint8,uint256)[] _options, string _metadata) returns()
+func (_Gov *GovSession) VoteWeighted(_proposalId uint64, _options []IGovWeightedVoteOption, _metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.VoteWeighted(&_Gov.TransactOpts, _proposalId, _options, _metadata)
+}
+
+// VoteWeighted is a paid mutator transaction binding the contract method 0x406d7224.
+//
+// Solidity: function voteWeighted(uint64 _proposalId, (uint8,uint256)[] _options, string _metadata) returns()
+func (_Gov *GovTransactorSession) VoteWeighted(_proposalId uint64, _options []IGovWeightedVoteOption, _metadata string) (*types.Transaction, error) {
+	return _Gov.Contract.VoteWeighted(&_Gov.TransactOpts, _proposalId, _options, _metadata)
+}
+
+// GovVoteIterator is returned from FilterVote and is used to iterate over the raw logs and unpacked data for Vote events raised by the Gov contract.
+type GovVoteIterator struct {
+	Event *GovVote // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovVoteIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovVote)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovVote)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovVoteIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovVoteIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovVote represents a Vote event raised by the Gov contract.
+type GovVote struct {
+	Voter      common.Address
+	ProposalId uint64
+	Option     uint8
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterVote is a free log retrieval operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) FilterVote(opts *bind.FilterOpts, voter []common.Address, proposalId []uint64) (*GovVoteIterator, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "Vote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovVoteIterator{contract: _Gov.contract, event: "Vote", logs: logs, sub: sub}, nil
+}
+
+// WatchVote is a free log subscription operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) WatchVote(opts *bind.WatchOpts, sink chan<- *GovVote, voter []common.Address, proposalId []uint64) (event.Subscription, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "Vote", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovVote)
+				if err := _Gov.contract.UnpackLog(event, "Vote", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVote is a log parse operation binding the contract event 0x71c096cfbbce3e73fe1d1e5943da8fcbdcd2ba95519bfa456d51c282c575c64a.
+//
+// Solidity: event Vote(address indexed voter, uint64 indexed proposalId, uint8 option)
+func (_Gov *GovFilterer) ParseVote(log types.Log) (*GovVote, error) {
+	event := new(GovVote)
+	if err := _Gov.contract.UnpackLog(event, "Vote", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovVoteWeightedIterator is returned from FilterVoteWeighted and is used to iterate over the raw logs and unpacked data for VoteWeighted events raised by the Gov contract.
+type GovVoteWeightedIterator struct {
+	Event *GovVoteWeighted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovVoteWeightedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovVoteWeighted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovVoteWeighted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovVoteWeightedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovVoteWeightedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovVoteWeighted represents a VoteWeighted event raised by the Gov contract.
+type GovVoteWeighted struct {
+	Voter      common.Address
+	ProposalId uint64
+	Options    []IGovWeightedVoteOption
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoteWeighted is a free log retrieval operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) FilterVoteWeighted(opts *bind.FilterOpts, voter []common.Address, proposalId []uint64) (*GovVoteWeightedIterator, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.FilterLogs(opts, "VoteWeighted", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovVoteWeightedIterator{contract: _Gov.contract, event: "VoteWeighted", logs: logs, sub: sub}, nil
+}
+
+// WatchVoteWeighted is a free log subscription operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) WatchVoteWeighted(opts *bind.WatchOpts, sink chan<- *GovVoteWeighted, voter []common.Address, proposalId []uint64) (event.Subscription, error) {
+
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
+	}
+	var proposalIdRule []interface{}
+	for _, proposalIdItem := range proposalId {
+		proposalIdRule = append(proposalIdRule, proposalIdItem)
+	}
+
+	logs, sub, err := _Gov.contract.WatchLogs(opts, "VoteWeighted", voterRule, proposalIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovVoteWeighted)
+				if err := _Gov.contract.UnpackLog(event, "VoteWeighted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoteWeighted is a log parse operation binding the contract event 0x86f93692c6759a384dc43eda8d01f7f8a1556bbb7d5351afc39e61710bca7a63.
+//
+// Solidity: event VoteWeighted(address indexed voter, uint64 indexed proposalId, (uint8,uint256)[] options)
+func (_Gov *GovFilterer) ParseVoteWeighted(log types.Log) (*GovVoteWeighted, error) {
+	event := new(GovVoteWeighted)
+	if err := _Gov.contract.UnpackLog(event, "VoteWeighted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,62 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	VoteEvent         = "Vote"
+	VoteWeightedEvent = "VoteWeighted"
+)
+
+func (g *GovPrecompile) EmitVoteEvent(ctx sdk.Context, stateDB *statedb.StateDB, voter common.Address, proposalId uint64, option uint8) error {
+	event := g.ABI.Events[VoteEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = voter
+	quries[2] = proposalId
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2]}
+	b, err := arguments.Pack(option)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     g.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (g *GovPrecompile) EmitVoteWeightedEvent(ctx sdk.Context, stateDB *statedb.StateDB, voter common.Address, proposalId uint64, options []IGovWeightedVoteOption) error {
+	event := g.ABI.Events[VoteWeightedEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = voter
+	quries[2] = proposalId
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2]}
+	b, err := arguments.Pack(options)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     g.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,80 @@
+package gov
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001003"
+
+	GovFunctionVote           = "vote"
+	GovFunctionVoteWeighted   = "voteWeighted"
+	GovFunctionGetProposal    = "getProposal"
+	GovFunctionGetTallyResult = "getTallyResult"
+)
+
+var RequiredGasBasic = map[string]uint64{
+	GovFunctionVote:           100000,
+	GovFunctionVoteWeighted:   120000,
+	GovFunctionGetProposal:    30000,
+	GovFunctionGetTallyResult: 30000,
+}
+
+var _ vm.PrecompiledContract = &GovPrecompile{}
+
+type GovPrecompile struct {
+	precopmiles_common.PrecompileBase
+	govKeeper *govkeeper.Keeper
+}
+
+// NewGovPrecompile keeps a reference to the gov keeper, which is built after the evm keeper and its precompiles. The
+// store access is metered, a tally iterates the votes and the delegations of the voters.
+func NewGovPrecompile(govKeeper *govkeeper.Keeper) (*GovPrecompile, error) {
+	base, err := precopmiles_common.NewPrecompileBase(GovABI, RequiredGasBasic)
+	if err != nil {
+		return nil, err
+	}
+	base.KVGasConfig = func(sdk.Context) storetypes.GasConfig {
+		return storetypes.KVGasConfig()
+	}
+	return &GovPrecompile{
+		PrecompileBase: base,
+		govKeeper:      govKeeper,
+	}, nil
+}
+
+// Address implements vm.PrecompiledContract.
+func (g *GovPrecompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// Run implements vm.PrecompiledContract.
+func (g *GovPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return g.Execute(evm, contract, readonly, func(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+		switch method.Name {
+		// queries
+		case GovFunctionGetProposal:
+			return g.GetProposal(ctx, evm, method, args)
+		case GovFunctionGetTallyResult:
+			return g.GetTallyResult(ctx, evm, method, args)
+		// txs
+		case GovFunctionVote:
+			return g.Vote(ctx, contract, stateDB, method, args)
+		case GovFunctionVoteWeighted:
+			return g.VoteWeighted(ctx, contract, stateDB, method, args)
+		}
+		return nil, vm.ErrExecutionReverted
+	})
+}
+
+func (g *GovPrecompile) msgServer() govv1.MsgServer {
+	return govkeeper.NewMsgServerImpl(g.govKeeper)
+}
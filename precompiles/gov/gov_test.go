@@ -0,0 +1,209 @@
+package gov_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	govprecompile "github.com/0glabs/0g-chain/precompiles/gov"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/suite"
+
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+type GovTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	abi       abi.ABI
+	addr      common.Address
+	gov       *govprecompile.GovPrecompile
+	signerOne *testutil.TestSigner
+}
+
+func (suite *GovTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+
+	suite.addr = common.HexToAddress(govprecompile.PrecompileAddress)
+
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	precompile, ok := precompiles[suite.addr]
+	suite.Assert().EqualValues(ok, true)
+	suite.gov = precompile.(*govprecompile.GovPrecompile)
+
+	suite.signerOne = testutil.GenSigner()
+	abi, err := abi.JSON(strings.NewReader(govprecompile.GovABI))
+	suite.Assert().NoError(err)
+	suite.abi = abi
+}
+
+func (suite *GovTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64) ([]byte, error) {
+	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), big.NewInt(0), gas)
+	contract.Input = input
+
+	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
+	msgEthereumTx.From = signer.HexAddr
+	err := msgEthereumTx.Sign(suite.EthSigner, signer.Signer)
+	suite.Assert().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+	cfg, err := suite.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, suite.EvmKeeper.ChainID())
+	suite.Assert().NoError(err, "failed to instantiate EVM config")
+
+	msg, err := msgEthereumTx.AsMessage(suite.EthSigner, big.NewInt(0))
+	suite.Assert().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := suite.EvmKeeper.NewEVM(suite.Ctx, msg, cfg, nil, suite.Statedb)
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	evm.WithPrecompiles(precompiles, []common.Address{suite.addr})
+
+	return suite.gov.Run(evm, contract, false)
+}
+
+// addVotingPower bonds a validator with the whole stake delegated by the voter
+func (suite *GovTestSuite) addVotingPower(voter common.Address, tokens sdk.Int) {
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	valAddr := sdk.ValAddress(testutil.GenSigner().Addr.Bytes())
+	validator, err := stakingtypes.NewValidator(valAddr, consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = tokens
+	validator.DelegatorShares = tokens.ToLegacyDec()
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	suite.StakingKeeper.SetValidatorByPowerIndex(suite.Ctx, validator)
+	suite.StakingKeeper.SetDelegation(suite.Ctx, stakingtypes.NewDelegation(sdk.AccAddress(voter.Bytes()), valAddr, tokens.ToLegacyDec()))
+}
+
+func (suite *GovTestSuite) submitProposal(proposer common.Address) uint64 {
+	govKeeper := suite.App.GetGovKeeper()
+	proposal, err := govKeeper.SubmitProposal(suite.Ctx, []sdk.Msg{}, "", "title", "summary", sdk.AccAddress(proposer.Bytes()))
+	suite.Require().NoError(err)
+	govKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+	return proposal.Id
+}
+
+func (suite *GovTestSuite) vote(testSigner *testutil.TestSigner, proposalId uint64, option govv1.VoteOption) {
+	input, err := suite.abi.Pack(
+		"vote",
+		proposalId,
+		uint8(option),
+		"",
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("Vote", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(uint8(option), out[0])
+}
+
+func (suite *GovTestSuite) voteWeighted(testSigner *testutil.TestSigner, proposalId uint64, options []govprecompile.IGovWeightedVoteOption) {
+	input, err := suite.abi.Pack(
+		"voteWeighted",
+		proposalId,
+		options,
+		"",
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("VoteWeighted", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(options, out[0])
+}
+
+func (suite *GovTestSuite) queryGetProposal(testSigner *testutil.TestSigner, proposalId uint64) govprecompile.IGovProposal {
+	input, err := suite.abi.Pack(
+		"getProposal",
+		proposalId,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["getProposal"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(govprecompile.IGovProposal)
+}
+
+func (suite *GovTestSuite) queryGetTallyResult(testSigner *testutil.TestSigner, proposalId uint64) govprecompile.IGovTallyResult {
+	input, err := suite.abi.Pack(
+		"getTallyResult",
+		proposalId,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["getTallyResult"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(govprecompile.IGovTallyResult)
+}
+
+func (suite *GovTestSuite) Test_Gov() {
+	power := sdk.NewInt(10_000_000)
+	suite.addVotingPower(suite.signerOne.Addr, power)
+	proposalId := suite.submitProposal(suite.signerOne.Addr)
+
+	proposal := suite.queryGetProposal(suite.signerOne, proposalId)
+	suite.Assert().EqualValues(proposalId, proposal.Id)
+	suite.Assert().EqualValues(govv1.StatusVotingPeriod, proposal.Status)
+	suite.Assert().EqualValues(suite.signerOne.Addr, proposal.Proposer)
+	suite.Assert().EqualValues("title", proposal.Title)
+	suite.Assert().NotZero(proposal.VotingEndTime)
+
+	// vote
+	suite.vote(suite.signerOne, proposalId, govv1.OptionYes)
+	tally := suite.queryGetTallyResult(suite.signerOne, proposalId)
+	suite.Assert().EqualValues(power.BigInt(), tally.Yes)
+	suite.Assert().Zero(tally.No.Sign())
+	// tallying does not consume the votes
+	tally = suite.queryGetTallyResult(suite.signerOne, proposalId)
+	suite.Assert().EqualValues(power.BigInt(), tally.Yes)
+	// the store access of the tally is metered
+	input, err := suite.abi.Pack("getTallyResult", proposalId)
+	suite.Assert().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000)
+	suite.Assert().ErrorIs(err, vm.ErrOutOfGas)
+
+	// weighted votes replace the vote
+	suite.voteWeighted(suite.signerOne, proposalId, []govprecompile.IGovWeightedVoteOption{
+		{Option: uint8(govv1.OptionYes), Weight: sdk.NewDecWithPrec(7, 1).BigInt()},
+		{Option: uint8(govv1.OptionNo), Weight: sdk.NewDecWithPrec(3, 1).BigInt()},
+	})
+	tally = suite.queryGetTallyResult(suite.signerOne, proposalId)
+	suite.Assert().EqualValues(big.NewInt(7_000_000), tally.Yes)
+	suite.Assert().EqualValues(big.NewInt(3_000_000), tally.No)
+
+	// invalid votes are rejected
+	input, err = suite.abi.Pack("vote", proposalId, uint8(govv1.OptionEmpty), "")
+	suite.Assert().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Assert().Error(err)
+	input, err = suite.abi.Pack("vote", proposalId+1, uint8(govv1.OptionYes), "")
+	suite.Assert().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000)
+	suite.Assert().Error(err)
+}
+
+func TestGovSuite(t *testing.T) {
+	suite.Run(t, new(GovTestSuite))
+}
@@ -0,0 +1,43 @@
+package gov
+
+import (
+	"fmt"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+func (g *GovPrecompile) GetProposal(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	response, err := g.govKeeper.Proposal(sdk.WrapSDKContext(ctx), &govv1.QueryProposalRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := NewIGovProposal(response.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(proposal)
+}
+
+func (g *GovPrecompile) GetTallyResult(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	// proposals in voting period are tallied on the fly, and the tally handler removes the votes it counts
+	cacheCtx, _ := ctx.CacheContext()
+	response, err := g.govKeeper.TallyResult(sdk.WrapSDKContext(cacheCtx), &govv1.QueryTallyResultRequest{ProposalId: args[0].(uint64)})
+	if err != nil {
+		return nil, err
+	}
+	tally, err := NewIGovTallyResult(response.Tally)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(tally)
+}
@@ -0,0 +1,46 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// the voter of the gov txs is the immediate caller, so multisig and DAO contracts vote with their own stake
+
+func (g *GovPrecompile) Vote(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgVote(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = g.msgServer().Vote(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = g.EmitVoteEvent(ctx, stateDB, contract.Caller(), msg.ProposalId, args[1].(uint8))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (g *GovPrecompile) VoteWeighted(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := NewMsgVoteWeighted(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = g.msgServer().VoteWeighted(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = g.EmitVoteWeightedEvent(ctx, stateDB, contract.Caller(), msg.ProposalId, args[1].([]IGovWeightedVoteOption))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
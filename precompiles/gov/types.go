@@ -0,0 +1,162 @@
+package gov
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IGovWeightedVoteOption = struct {
+	Option uint8    "json:\"option\""
+	Weight *big.Int "json:\"weight\""
+}
+
+type IGovTallyResult = struct {
+	Yes        *big.Int "json:\"yes\""
+	Abstain    *big.Int "json:\"abstain\""
+	No         *big.Int "json:\"no\""
+	NoWithVeto *big.Int "json:\"noWithVeto\""
+}
+
+type IGovCoin = struct {
+	Denom  string   "json:\"denom\""
+	Amount *big.Int "json:\"amount\""
+}
+
+type IGovProposal = struct {
+	Id               uint64          "json:\"id\""
+	Status           uint8           "json:\"status\""
+	Proposer         common.Address  "json:\"proposer\""
+	Title            string          "json:\"title\""
+	Summary          string          "json:\"summary\""
+	Metadata         string          "json:\"metadata\""
+	SubmitTime       int64           "json:\"submitTime\""
+	DepositEndTime   int64           "json:\"depositEndTime\""
+	VotingStartTime  int64           "json:\"votingStartTime\""
+	VotingEndTime    int64           "json:\"votingEndTime\""
+	FinalTallyResult IGovTallyResult "json:\"finalTallyResult\""
+	TotalDeposit     []IGovCoin      "json:\"totalDeposit\""
+}
+
+// ToAccAddress converts an EVM address to the account address it controls
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// toUnix returns the unix time in seconds, zero for times not reached yet
+func toUnix(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+func parseCount(count string) (*big.Int, error) {
+	if count == "" {
+		return big.NewInt(0), nil
+	}
+	amount, ok := sdkmath.NewIntFromString(count)
+	if !ok {
+		return nil, fmt.Errorf("invalid tally count %s", count)
+	}
+	return amount.BigInt(), nil
+}
+
+func NewIGovTallyResult(tally *govv1.TallyResult) (IGovTallyResult, error) {
+	if tally == nil {
+		tally = &govv1.TallyResult{}
+	}
+	counts := make([]*big.Int, 4)
+	for i, count := range []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount} {
+		amount, err := parseCount(count)
+		if err != nil {
+			return IGovTallyResult{}, err
+		}
+		counts[i] = amount
+	}
+	return IGovTallyResult{
+		Yes:        counts[0],
+		Abstain:    counts[1],
+		No:         counts[2],
+		NoWithVeto: counts[3],
+	}, nil
+}
+
+func NewIGovProposal(proposal *govv1.Proposal) (IGovProposal, error) {
+	var proposer common.Address
+	if proposal.Proposer != "" {
+		accAddr, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return IGovProposal{}, err
+		}
+		proposer = common.BytesToAddress(accAddr)
+	}
+	tally, err := NewIGovTallyResult(proposal.FinalTallyResult)
+	if err != nil {
+		return IGovProposal{}, err
+	}
+	deposit := make([]IGovCoin, len(proposal.TotalDeposit))
+	for i, coin := range proposal.TotalDeposit {
+		deposit[i] = IGovCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		}
+	}
+	return IGovProposal{
+		Id:               proposal.Id,
+		Status:           uint8(proposal.Status),
+		Proposer:         proposer,
+		Title:            proposal.Title,
+		Summary:          proposal.Summary,
+		Metadata:         proposal.Metadata,
+		SubmitTime:       toUnix(proposal.SubmitTime),
+		DepositEndTime:   toUnix(proposal.DepositEndTime),
+		VotingStartTime:  toUnix(proposal.VotingStartTime),
+		VotingEndTime:    toUnix(proposal.VotingEndTime),
+		FinalTallyResult: tally,
+		TotalDeposit:     deposit,
+	}, nil
+}
+
+func NewMsgVote(args []interface{}, voter common.Address) (*govv1.MsgVote, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	msg := &govv1.MsgVote{
+		ProposalId: args[0].(uint64),
+		Voter:      ToAccAddress(voter).String(),
+		Option:     govv1.VoteOption(args[1].(uint8)),
+		Metadata:   args[2].(string),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// NewMsgVoteWeighted converts the vote, the option weights are given with 18 fractional digits
+func NewMsgVoteWeighted(args []interface{}, voter common.Address) (*govv1.MsgVoteWeighted, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	options := args[1].([]IGovWeightedVoteOption)
+	weighted := make([]*govv1.WeightedVoteOption, len(options))
+	for i, option := range options {
+		weighted[i] = &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(option.Option),
+			Weight: sdk.NewDecFromBigIntWithPrec(option.Weight, sdk.Precision).String(),
+		}
+	}
+	msg := &govv1.MsgVoteWeighted{
+		ProposalId: args[0].(uint64),
+		Voter:      ToAccAddress(voter).String(),
+		Options:    weighted,
+		Metadata:   args[2].(string),
+	}
+	return msg, msg.ValidateBasic()
+}
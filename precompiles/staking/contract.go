@@ -0,0 +1,999 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package staking
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// StakingMetaData contains all meta data concerning the Staking contract.
+var StakingMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"}],\"name\":\"CancelUnbondingDelegation\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Delegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validatorSrc\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validatorDst\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"int64\",\"name\":\"completionTime\",\"type\":\"int64\"}],\"name\":\"Redelegate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"int64\",\"name\":\"completionTime\",\"type\":\"int64\"}],\"name\":\"Undelegate\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"_creationHeight\",\"type\":\"int64\"}],\"name\":\"cancelUnbondingDelegation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"delegate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"delegation\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"shares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validatorSrc\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_validatorDst\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"redelegate\",\"outputs\":[{\"internalType\":\"int64\",\"name\":\"completionTime\",\"type\":\"int64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"unbondingDelegation\",\"outputs\":[{\"components\":[{\"internalType\":\"int64\",\"name\":\"creationHeight\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"completionTime\",\"type\":\"int64\"},{\"internalType\":\"uint256\",\"name\":\"initialBalance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"internalType\":\"structIStaking.UnbondingDelegationEntry[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"undelegate\",\"outputs\":[{\"internalType\":\"int64\",\"name\":\"completionTime\",\"type\":\"int64\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"}],\"name\":\"validator\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAddress\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"jailed\",\"type\":\"bool\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"tokens\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegatorShares\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"moniker\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minSelfDelegation\",\"type\":\"uint256\"}],\"internalType\":\"structIStaking.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// StakingABI is the input ABI used to generate the binding from.
+// Deprecated: Use StakingMetaData.ABI instead.
+var StakingABI = StakingMetaData.ABI
+
+// Staking is an auto generated Go binding around an Ethereum contract.
+type Staking struct {
+	StakingCaller     // Read-only binding to the contract
+	StakingTransactor // Write-only binding to the contract
+	StakingFilterer   // Log filterer for contract events
+}
+
+// StakingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakingSession struct {
+	Contract     *Staking          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakingCallerSession struct {
+	Contract *StakingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StakingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakingTransactorSession struct {
+	Contract     *StakingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StakingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakingRaw struct {
+	Contract *Staking // Generic contract binding to access the raw methods on
+}
+
+// StakingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakingCallerRaw struct {
+	Contract *StakingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakingTransactorRaw struct {
+	Contract *StakingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStaking creates a new instance of Staking, bound to a specific deployed contract.
+func NewStaking(address common.Address, backend bind.ContractBackend) (*Staking, error) {
+	contract, err := bindStaking(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Staking{StakingCaller: StakingCaller{contract: contract}, StakingTransactor: StakingTransactor{contract: contract}, StakingFilterer: StakingFilterer{contract: contract}}, nil
+}
+
+// NewStakingCaller creates a new read-only instance of Staking, bound to a specific deployed contract.
+func NewStakingCaller(address common.Address, caller bind.ContractCaller) (*StakingCaller, error) {
+	contract, err := bindStaking(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingCaller{contract: contract}, nil
+}
+
+// NewStakingTransactor creates a new write-only instance of Staking, bound to a specific deployed contract.
+func NewStakingTransactor(address common.Address, transactor bind.ContractTransactor) (*StakingTransactor, error) {
+	contract, err := bindStaking(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingTransactor{contract: contract}, nil
+}
+
+// NewStakingFilterer creates a new log filterer instance of Staking, bound to a specific deployed contract.
+func NewStakingFilterer(address common.Address, filterer bind.ContractFilterer) (*StakingFilterer, error) {
+	contract, err := bindStaking(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingFilterer{contract: contract}, nil
+}
+
+// bindStaking binds a generic wrapper to an already deployed contract.
+func bindStaking(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StakingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.StakingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transact(opts, method, params...)
+}
+
+// Delegation is a free data retrieval call binding the contract method 0x046d3307.
+//
+// Solidity: function delegation(address _delegator, address _validator) view returns(uint256 shares, uint256 balance)
+func (_Staking *StakingCaller) Delegation(opts *bind.CallOpts, _delegator common.Address, _validator common.Address) (struct {
+	Shares  *big.Int
+	Balance *big.Int
+}, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "delegation", _delegator, _validator)
+
+	outstruct := new(struct {
+		Shares  *big.Int
+		Balance *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Shares = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Balance = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// Delegation is a free data retrieval call binding the contract method 0x046d3307.
+//
+// Solidity: function delegation(address _delegator, address _validator) view returns(uint256 shares, uint256 balance)
+func (_Staking *StakingSession) Delegation(_delegator common.Address, _validator common.Address) (struct {
+	Shares  *big.Int
+	Balance *big.Int
+}, error) {
+	return _Staking.Contract.Delegation(&_Staking.CallOpts, _delegator, _validator)
+}
+
+// Delegation is a free data retrieval call binding the contract method 0x046d3307.
+//
+// Solidity: function delegation(address _delegator, address _validator) view returns(uint256 shares, uint256 balance)
+func (_Staking *StakingCallerSession) Delegation(_delegator common.Address, _validator common.Address) (struct {
+	Shares  *big.Int
+	Balance *big.Int
+}, error) {
+	return _Staking.Contract.Delegation(&_Staking.CallOpts, _delegator, _validator)
+}
+
+// UnbondingDelegation is a free data retrieval call binding the contract method 0x97e41907.
+//
+// Solidity: function unbondingDelegation(address _delegator, address _validator) view returns((int64,int64,uint256,uint256)[])
+func (_Staking *StakingCaller) UnbondingDelegation(opts *bind.CallOpts, _delegator common.Address, _validator common.Address) ([]IStakingUnbondingDelegationEntry, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "unbondingDelegation", _delegator, _validator)
+
+	if err != nil {
+		return *new([]IStakingUnbondingDelegationEntry), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]IStakingUnbondingDelegationEntry)).(*[]IStakingUnbondingDelegationEntry)
+
+	return out0, err
+
+}
+
+// UnbondingDelegation is a free data retrieval call binding the contract method 0x97e41907.
+//
+// Solidity: function unbondingDelegation(address _delegator, address _validator) view returns((int64,int64,uint256,uint256)[])
+func (_Staking *StakingSession) UnbondingDelegation(_delegator common.Address, _validator common.Address) ([]IStakingUnbondingDelegationEntry, error) {
+	return _Staking.Contract.UnbondingDelegation(&_Staking.CallOpts, _delegator, _validator)
+}
+
+// UnbondingDelegation is a free data retrieval call binding the contract method 0x97e41907.
+//
+// Solidity: function unbondingDelegation(address _delegator, address _validator) view returns((int64,int64,uint256,uint256)[])
+func (_Staking *StakingCallerSession) UnbondingDelegation(_delegator common.Address, _validator common.Address) ([]IStakingUnbondingDelegationEntry, error) {
+	return _Staking.Contract.UnbondingDelegation(&_Staking.CallOpts, _delegator, _validator)
+}
+
+// Validator is a free data retrieval call binding the contract method 0x223b3b7a.
+//
+// Solidity: function validator(address _validator) view returns((address,bool,uint8,uint256,uint256,string,uint256,uint256))
+func (_Staking *StakingCaller) Validator(opts *bind.CallOpts, _validator common.Address) (IStakingValidator, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "validator", _validator)
+
+	if err != nil {
+		return *new(IStakingValidator), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(IStakingValidator)).(*IStakingValidator)
+
+	return out0, err
+
+}
+
+// Validator is a free data retrieval call binding the contract method 0x223b3b7a.
+//
+// Solidity: function validator(address _validator) view returns((address,bool,uint8,uint256,uint256,string,uint256,uint256))
+func (_Staking *StakingSession) Validator(_validator common.Address) (IStakingValidator, error) {
+	return _Staking.Contract.Validator(&_Staking.CallOpts, _validator)
+}
+
+// Validator is a free data retrieval call binding the contract method 0x223b3b7a.
+//
+// Solidity: function validator(address _validator) view returns((address,bool,uint8,uint256,uint256,string,uint256,uint256))
+func (_Staking *StakingCallerSession) Validator(_validator common.Address) (IStakingValidator, error) {
+	return _Staking.Contract.Validator(&_Staking.CallOpts, _validator)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0x69a2f536.
+//
+// Solidity: function cancelUnbondingDelegation(address _validator, uint256 _amount, int64 _creationHeight) returns()
+func (_Staking *StakingTransactor) CancelUnbondingDelegation(opts *bind.TransactOpts, _validator common.Address, _amount *big.Int, _creationHeight int64) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "cancelUnbondingDelegation", _validator, _amount, _creationHeight)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0x69a2f536.
+//
+// Solidity: function cancelUnbondingDelegation(address _validator, uint256 _amount, int64 _creationHeight) returns()
+func (_Staking *StakingSession) CancelUnbondingDelegation(_validator common.Address, _amount *big.Int, _creationHeight int64) (*types.Transaction, error) {
+	return _Staking.Contract.CancelUnbondingDelegation(&_Staking.TransactOpts, _validator, _amount, _creationHeight)
+}
+
+// CancelUnbondingDelegation is a paid mutator transaction binding the contract method 0x69a2f536.
+//
+// Solidity: function cancelUnbondingDelegation(address _validator, uint256 _amount, int64 _creationHeight) returns()
+func (_Staking *StakingTransactorSession) CancelUnbondingDelegation(_validator common.Address, _amount *big.Int, _creationHeight int64) (*types.Transaction, error) {
+	return _Staking.Contract.CancelUnbondingDelegation(&_Staking.TransactOpts, _validator, _amount, _creationHeight)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x026e402b.
+//
+// Solidity: function delegate(address _validator, uint256 _amount) returns()
+func (_Staking *StakingTransactor) Delegate(opts *bind.TransactOpts, _validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "delegate", _validator, _amount)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x026e402b.
+//
+// Solidity: function delegate(address _validator, uint256 _amount) returns()
+func (_Staking *StakingSession) Delegate(_validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Delegate(&_Staking.TransactOpts, _validator, _amount)
+}
+
+// Delegate is a paid mutator transaction binding the contract method 0x026e402b.
+//
+// Solidity: function delegate(address _validator, uint256 _amount) returns()
+func (_Staking *StakingTransactorSession) Delegate(_validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Delegate(&_Staking.TransactOpts, _validator, _amount)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address _validatorSrc, address _validatorDst, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingTransactor) Redelegate(opts *bind.TransactOpts, _validatorSrc common.Address, _validatorDst common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "redelegate", _validatorSrc, _validatorDst, _amount)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address _validatorSrc, address _validatorDst, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingSession) Redelegate(_validatorSrc common.Address, _validatorDst common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Redelegate(&_Staking.TransactOpts, _validatorSrc, _validatorDst, _amount)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address _validatorSrc, address _validatorDst, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingTransactorSession) Redelegate(_validatorSrc common.Address, _validatorDst common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Redelegate(&_Staking.TransactOpts, _validatorSrc, _validatorDst, _amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address _validator, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingTransactor) Undelegate(opts *bind.TransactOpts, _validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "undelegate", _validator, _amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address _validator, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingSession) Undelegate(_validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, _validator, _amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address _validator, uint256 _amount) returns(int64 completionTime)
+func (_Staking *StakingTransactorSession) Undelegate(_validator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, _validator, _amount)
+}
+
+// StakingCancelUnbondingDelegationIterator is returned from FilterCancelUnbondingDelegation and is used to iterate over the raw logs and unpacked data for CancelUnbondingDelegation events raised by the Staking contract.
+type StakingCancelUnbondingDelegationIterator struct {
+	Event *StakingCancelUnbondingDelegation // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingCancelUnbondingDelegationIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingCancelUnbondingDelegation)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingCancelUnbondingDelegation)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingCancelUnbondingDelegationIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingCancelUnbondingDelegationIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingCancelUnbondingDelegation represents a CancelUnbondingDelegation event raised by the Staking contract.
+type StakingCancelUnbondingDelegation struct {
+	Delegator      common.Address
+	Validator      common.Address
+	Amount         *big.Int
+	CreationHeight int64
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterCancelUnbondingDelegation is a free log retrieval operation binding the contract event 0x4022879e779e0c889f536ac78c7e10619fc795d7af0efabf4500309a187588d9.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed delegator, address indexed validator, uint256 amount, int64 creationHeight)
+func (_Staking *StakingFilterer) FilterCancelUnbondingDelegation(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingCancelUnbondingDelegationIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "CancelUnbondingDelegation", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingCancelUnbondingDelegationIterator{contract: _Staking.contract, event: "CancelUnbondingDelegation", logs: logs, sub: sub}, nil
+}
+
+// WatchCancelUnbondingDelegation is a free log subscription operation binding the contract event 0x4022879e779e0c889f536ac78c7e10619fc795d7af0efabf4500309a187588d9.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed delegator, address indexed validator, uint256 amount, int64 creationHeight)
+func (_Staking *StakingFilterer) WatchCancelUnbondingDelegation(opts *bind.WatchOpts, sink chan<- *StakingCancelUnbondingDelegation, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "CancelUnbondingDelegation", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingCancelUnbondingDelegation)
+				if err := _Staking.contract.UnpackLog(event, "CancelUnbondingDelegation", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCancelUnbondingDelegation is a log parse operation binding the contract event 0x4022879e779e0c889f536ac78c7e10619fc795d7af0efabf4500309a187588d9.
+//
+// Solidity: event CancelUnbondingDelegation(address indexed delegator, address indexed validator, uint256 amount, int64 creationHeight)
+func (_Staking *StakingFilterer) ParseCancelUnbondingDelegation(log types.Log) (*StakingCancelUnbondingDelegation, error) {
+	event := new(StakingCancelUnbondingDelegation)
+	if err := _Staking.contract.UnpackLog(event, "CancelUnbondingDelegation", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingDelegateIterator is returned from FilterDelegate and is used to iterate over the raw logs and unpacked data for Delegate events raised by the Staking contract.
+type StakingDelegateIterator struct {
+	Event *StakingDelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingDelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingDelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingDelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingDelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingDelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingDelegate represents a Delegate event raised by the Staking contract.
+type StakingDelegate struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterDelegate is a free log retrieval operation binding the contract event 0x510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc.
+//
+// Solidity: event Delegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) FilterDelegate(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingDelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Delegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingDelegateIterator{contract: _Staking.contract, event: "Delegate", logs: logs, sub: sub}, nil
+}
+
+// WatchDelegate is a free log subscription operation binding the contract event 0x510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc.
+//
+// Solidity: event Delegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) WatchDelegate(opts *bind.WatchOpts, sink chan<- *StakingDelegate, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Delegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingDelegate)
+				if err := _Staking.contract.UnpackLog(event, "Delegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDelegate is a log parse operation binding the contract event 0x510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc.
+//
+// Solidity: event Delegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) ParseDelegate(log types.Log) (*StakingDelegate, error) {
+	event := new(StakingDelegate)
+	if err := _Staking.contract.UnpackLog(event, "Delegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingRedelegateIterator is returned from FilterRedelegate and is used to iterate over the raw logs and unpacked data for Redelegate events raised by the Staking contract.
+type StakingRedelegateIterator struct {
+	Event *StakingRedelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingRedelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingRedelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingRedelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingRedelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingRedelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingRedelegate represents a Redelegate event raised by the Staking contract.
+type StakingRedelegate struct {
+	Delegator      common.Address
+	ValidatorSrc   common.Address
+	ValidatorDst   common.Address
+	Amount         *big.Int
+	CompletionTime int64
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterRedelegate is a free log retrieval operation binding the contract event 0x0db54172f863acecb51c5c25c0b8b6e1714ea599ac14effa6a869e9674ef802c.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed validatorSrc, address indexed validatorDst, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) FilterRedelegate(opts *bind.FilterOpts, delegator []common.Address, validatorSrc []common.Address, validatorDst []common.Address) (*StakingRedelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorSrcRule []interface{}
+	for _, validatorSrcItem := range validatorSrc {
+		validatorSrcRule = append(validatorSrcRule, validatorSrcItem)
+	}
+	var validatorDstRule []interface{}
+	for _, validatorDstItem := range validatorDst {
+		validatorDstRule = append(validatorDstRule, validatorDstItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Redelegate", delegatorRule, validatorSrcRule, validatorDstRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingRedelegateIterator{contract: _Staking.contract, event: "Redelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchRedelegate is a free log subscription operation binding the contract event 0x0db54172f863acecb51c5c25c0b8b6e1714ea599ac14effa6a869e9674ef802c.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed validatorSrc, address indexed validatorDst, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) WatchRedelegate(opts *bind.WatchOpts, sink chan<- *StakingRedelegate, delegator []common.Address, validatorSrc []common.Address, validatorDst []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorSrcRule []interface{}
+	for _, validatorSrcItem := range validatorSrc {
+		validatorSrcRule = append(validatorSrcRule, validatorSrcItem)
+	}
+	var validatorDstRule []interface{}
+	for _, validatorDstItem := range validatorDst {
+		validatorDstRule = append(validatorDstRule, validatorDstItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Redelegate", delegatorRule, validatorSrcRule, validatorDstRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingRedelegate)
+				if err := _Staking.contract.UnpackLog(event, "Redelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRedelegate is a log parse operation binding the contract event 0x0db54172f863acecb51c5c25c0b8b6e1714ea599ac14effa6a869e9674ef802c.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed validatorSrc, address indexed validatorDst, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) ParseRedelegate(log types.Log) (*StakingRedelegate, error) {
+	event := new(StakingRedelegate)
+	if err := _Staking.contract.UnpackLog(event, "Redelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingUndelegateIterator is returned from FilterUndelegate and is used to iterate over the raw logs and unpacked data for Undelegate events raised by the Staking contract.
+type StakingUndelegateIterator struct {
+	Event *StakingUndelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUndelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUndelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUndelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUndelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUndelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUndelegate represents a Undelegate event raised by the Staking contract.
+type StakingUndelegate struct {
+	Delegator      common.Address
+	Validator      common.Address
+	Amount         *big.Int
+	CompletionTime int64
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUndelegate is a free log retrieval operation binding the contract event 0xb1023fd326bc6e585fc2880032048cf5569992d6ff8f057d4a3358eb3e85c34a.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) FilterUndelegate(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingUndelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUndelegateIterator{contract: _Staking.contract, event: "Undelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchUndelegate is a free log subscription operation binding the contract event 0xb1023fd326bc6e585fc2880032048cf5569992d6ff8f057d4a3358eb3e85c34a.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) WatchUndelegate(opts *bind.WatchOpts, sink chan<- *StakingUndelegate, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUndelegate)
+				if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUndelegate is a log parse operation binding the contract event 0xb1023fd326bc6e585fc2880032048cf5569992d6ff8f057d4a3358eb3e85c34a.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount, int64 completionTime)
+func (_Staking *StakingFilterer) ParseUndelegate(log types.Log) (*StakingUndelegate, error) {
+	event := new(StakingUndelegate)
+	if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,115 @@
+package staking
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	DelegateEvent                  = "Delegate"
+	UndelegateEvent                = "Undelegate"
+	RedelegateEvent                = "Redelegate"
+	CancelUnbondingDelegationEvent = "CancelUnbondingDelegation"
+)
+
+func (s *StakingPrecompile) EmitDelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator common.Address, amount *big.Int) error {
+	event := s.ABI.Events[DelegateEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = delegator
+	quries[2] = validator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2]}
+	b, err := arguments.Pack(amount)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     s.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (s *StakingPrecompile) EmitUndelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator common.Address, amount *big.Int, completionTime int64) error {
+	event := s.ABI.Events[UndelegateEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = delegator
+	quries[2] = validator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	b, err := arguments.Pack(amount, completionTime)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     s.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (s *StakingPrecompile) EmitRedelegateEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validatorSrc common.Address, validatorDst common.Address, amount *big.Int, completionTime int64) error {
+	event := s.ABI.Events[RedelegateEvent]
+	quries := make([]interface{}, 4)
+	quries[0] = event.ID
+	quries[1] = delegator
+	quries[2] = validatorSrc
+	quries[3] = validatorDst
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[3], event.Inputs[4]}
+	b, err := arguments.Pack(amount, completionTime)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     s.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
+
+func (s *StakingPrecompile) EmitCancelUnbondingDelegationEvent(ctx sdk.Context, stateDB *statedb.StateDB, delegator common.Address, validator common.Address, amount *big.Int, creationHeight int64) error {
+	event := s.ABI.Events[CancelUnbondingDelegationEvent]
+	quries := make([]interface{}, 3)
+	quries[0] = event.ID
+	quries[1] = delegator
+	quries[2] = validator
+	topics, err := abi.MakeTopics(quries)
+	if err != nil {
+		return err
+	}
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	b, err := arguments.Pack(amount, creationHeight)
+	if err != nil {
+		return err
+	}
+	stateDB.AddLog(&types.Log{
+		Address:     s.Address(),
+		Topics:      topics[0],
+		Data:        b,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
@@ -0,0 +1,62 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Delegation returns the shares and the token balance of the delegation, both zero if it does not exist
+func (s *StakingPrecompile) Delegation(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	delegator := ToAccAddress(args[0].(common.Address))
+	valAddr := ToValAddress(args[1].(common.Address))
+	delegation, found := s.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+	}
+	validator, found := s.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	balance := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	return method.Outputs.Pack(delegation.Shares.BigInt(), balance.BigInt())
+}
+
+func (s *StakingPrecompile) Validator(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	validator, found := s.stakingKeeper.GetValidator(ctx, ToValAddress(args[0].(common.Address)))
+	if !found {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	result, err := NewIStakingValidator(validator)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(result)
+}
+
+// UnbondingDelegation returns the entries of the unbonding delegation, their creation heights are needed to cancel them
+func (s *StakingPrecompile) UnbondingDelegation(ctx sdk.Context, _ *vm.EVM, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	ubd, found := s.stakingKeeper.GetUnbondingDelegation(ctx, ToAccAddress(args[0].(common.Address)), ToValAddress(args[1].(common.Address)))
+	entries := make([]IStakingUnbondingDelegationEntry, 0)
+	if found {
+		for _, entry := range ubd.Entries {
+			entries = append(entries, NewIStakingUnbondingDelegationEntry(entry))
+		}
+	}
+	return method.Outputs.Pack(entries)
+}
@@ -0,0 +1,86 @@
+package staking
+
+import (
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+const (
+	PrecompileAddress = "0x0000000000000000000000000000000000001001"
+
+	StakingFunctionDelegate                  = "delegate"
+	StakingFunctionUndelegate                = "undelegate"
+	StakingFunctionRedelegate                = "redelegate"
+	StakingFunctionCancelUnbondingDelegation = "cancelUnbondingDelegation"
+	StakingFunctionDelegation                = "delegation"
+	StakingFunctionValidator                 = "validator"
+	StakingFunctionUnbondingDelegation       = "unbondingDelegation"
+)
+
+var RequiredGasBasic = map[string]uint64{
+	StakingFunctionDelegate:                  200000,
+	StakingFunctionUndelegate:                200000,
+	StakingFunctionRedelegate:                250000,
+	StakingFunctionCancelUnbondingDelegation: 200000,
+	StakingFunctionDelegation:                10000,
+	StakingFunctionValidator:                 10000,
+	StakingFunctionUnbondingDelegation:       20000,
+}
+
+var _ vm.PrecompiledContract = &StakingPrecompile{}
+
+type StakingPrecompile struct {
+	precopmiles_common.PrecompileBase
+	stakingKeeper *stakingkeeper.Keeper
+}
+
+func NewStakingPrecompile(stakingKeeper *stakingkeeper.Keeper) (*StakingPrecompile, error) {
+	base, err := precopmiles_common.NewPrecompileBase(StakingABI, RequiredGasBasic)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingPrecompile{
+		PrecompileBase: base,
+		stakingKeeper:  stakingKeeper,
+	}, nil
+}
+
+// Address implements vm.PrecompiledContract.
+func (s *StakingPrecompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// Run implements vm.PrecompiledContract.
+func (s *StakingPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return s.Execute(evm, contract, readonly, func(ctx sdk.Context, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+		switch method.Name {
+		// queries
+		case StakingFunctionDelegation:
+			return s.Delegation(ctx, evm, method, args)
+		case StakingFunctionValidator:
+			return s.Validator(ctx, evm, method, args)
+		case StakingFunctionUnbondingDelegation:
+			return s.UnbondingDelegation(ctx, evm, method, args)
+		// txs
+		case StakingFunctionDelegate:
+			return s.Delegate(ctx, contract, stateDB, method, args)
+		case StakingFunctionUndelegate:
+			return s.Undelegate(ctx, contract, stateDB, method, args)
+		case StakingFunctionRedelegate:
+			return s.Redelegate(ctx, contract, stateDB, method, args)
+		case StakingFunctionCancelUnbondingDelegation:
+			return s.CancelUnbondingDelegation(ctx, contract, stateDB, method, args)
+		}
+		return nil, vm.ErrExecutionReverted
+	})
+}
+
+func (s *StakingPrecompile) msgServer() stakingtypes.MsgServer {
+	return stakingkeeper.NewMsgServerImpl(s.stakingKeeper)
+}
@@ -0,0 +1,319 @@
+package staking_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/0glabs/0g-chain/chaincfg"
+	stakingprecompile "github.com/0glabs/0g-chain/precompiles/staking"
+	"github.com/0glabs/0g-chain/precompiles/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/suite"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+type StakingTestSuite struct {
+	testutil.PrecompileTestSuite
+
+	abi       abi.ABI
+	addr      common.Address
+	staking   *stakingprecompile.StakingPrecompile
+	signerOne *testutil.TestSigner
+}
+
+func (suite *StakingTestSuite) SetupTest() {
+	suite.PrecompileTestSuite.SetupTest()
+
+	suite.addr = common.HexToAddress(stakingprecompile.PrecompileAddress)
+
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	precompile, ok := precompiles[suite.addr]
+	suite.Assert().EqualValues(ok, true)
+	suite.staking = precompile.(*stakingprecompile.StakingPrecompile)
+
+	suite.signerOne = testutil.GenSigner()
+	abi, err := abi.JSON(strings.NewReader(stakingprecompile.StakingABI))
+	suite.Assert().NoError(err)
+	suite.abi = abi
+}
+
+func (suite *StakingTestSuite) runTx(input []byte, signer *testutil.TestSigner, gas uint64, readonly bool) ([]byte, error) {
+	contract := vm.NewPrecompile(vm.AccountRef(signer.Addr), vm.AccountRef(suite.addr), big.NewInt(0), gas)
+	contract.Input = input
+
+	msgEthereumTx := evmtypes.NewTx(suite.EvmKeeper.ChainID(), 0, &suite.addr, big.NewInt(0), gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil)
+	msgEthereumTx.From = signer.HexAddr
+	err := msgEthereumTx.Sign(suite.EthSigner, signer.Signer)
+	suite.Assert().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := suite.Ctx.BlockHeader().ProposerAddress
+	cfg, err := suite.EvmKeeper.EVMConfig(suite.Ctx, proposerAddress, suite.EvmKeeper.ChainID())
+	suite.Assert().NoError(err, "failed to instantiate EVM config")
+
+	msg, err := msgEthereumTx.AsMessage(suite.EthSigner, big.NewInt(0))
+	suite.Assert().NoError(err, "failed to instantiate Ethereum message")
+
+	evm := suite.EvmKeeper.NewEVM(suite.Ctx, msg, cfg, nil, suite.Statedb)
+	precompiles := suite.EvmKeeper.GetPrecompiles()
+	evm.WithPrecompiles(precompiles, []common.Address{suite.addr})
+
+	return suite.staking.Run(evm, contract, readonly)
+}
+
+func (suite *StakingTestSuite) addValidator() common.Address {
+	addr := testutil.GenSigner().Addr
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(addr.Bytes()), consPriv.PubKey(), stakingtypes.Description{Moniker: "validator"})
+	suite.Require().NoError(err)
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	// SetValidator bypasses the staking hooks, distribution tracks the validator from its creation
+	err = suite.StakingKeeper.Hooks().AfterValidatorCreated(suite.Ctx, validator.GetOperator())
+	suite.Require().NoError(err)
+	return addr
+}
+
+func (suite *StakingTestSuite) delegate(testSigner *testutil.TestSigner, validator common.Address, amount *big.Int) {
+	input, err := suite.abi.Pack(
+		"delegate",
+		validator,
+		amount,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000, false)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("Delegate", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(amount, out[0])
+}
+
+func (suite *StakingTestSuite) undelegate(testSigner *testutil.TestSigner, validator common.Address, amount *big.Int) {
+	input, err := suite.abi.Pack(
+		"undelegate",
+		validator,
+		amount,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	bz, err := suite.runTx(input, testSigner, 10000000, false)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["undelegate"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	completionTime := out[0].(int64)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err = suite.abi.Unpack("Undelegate", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(amount, out[0])
+	suite.Assert().EqualValues(completionTime, out[1])
+}
+
+func (suite *StakingTestSuite) redelegate(testSigner *testutil.TestSigner, validatorSrc common.Address, validatorDst common.Address, amount *big.Int) {
+	input, err := suite.abi.Pack(
+		"redelegate",
+		validatorSrc,
+		validatorDst,
+		amount,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000, false)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("Redelegate", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(amount, out[0])
+}
+
+func (suite *StakingTestSuite) cancelUnbondingDelegation(testSigner *testutil.TestSigner, validator common.Address, amount *big.Int, creationHeight int64) {
+	input, err := suite.abi.Pack(
+		"cancelUnbondingDelegation",
+		validator,
+		amount,
+		creationHeight,
+	)
+	suite.Assert().NoError(err)
+
+	oldLogs := suite.Statedb.Logs()
+	_, err = suite.runTx(input, testSigner, 10000000, false)
+	suite.Assert().NoError(err)
+	logs := suite.Statedb.Logs()
+	suite.Assert().EqualValues(len(logs), len(oldLogs)+1)
+
+	out, err := suite.abi.Unpack("CancelUnbondingDelegation", logs[len(logs)-1].Data)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(amount, out[0])
+	suite.Assert().EqualValues(creationHeight, out[1])
+}
+
+func (suite *StakingTestSuite) queryDelegation(testSigner *testutil.TestSigner, delegator common.Address, validator common.Address) *big.Int {
+	input, err := suite.abi.Pack(
+		"delegation",
+		delegator,
+		validator,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000, true)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["delegation"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	// shares are returned with 18 fractional digits
+	shares := sdk.NewDecFromBigIntWithPrec(out[0].(*big.Int), sdk.Precision)
+	suite.Assert().Zero(shares.TruncateInt().BigInt().Cmp(out[1].(*big.Int)))
+	return out[1].(*big.Int)
+}
+
+func (suite *StakingTestSuite) queryValidator(testSigner *testutil.TestSigner, validator common.Address) stakingprecompile.IStakingValidator {
+	input, err := suite.abi.Pack(
+		"validator",
+		validator,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000, true)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["validator"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].(stakingprecompile.IStakingValidator)
+}
+
+func (suite *StakingTestSuite) queryUnbondingDelegation(testSigner *testutil.TestSigner, delegator common.Address, validator common.Address) []stakingprecompile.IStakingUnbondingDelegationEntry {
+	input, err := suite.abi.Pack(
+		"unbondingDelegation",
+		delegator,
+		validator,
+	)
+	suite.Assert().NoError(err)
+
+	bz, err := suite.runTx(input, testSigner, 10000000, true)
+	suite.Assert().NoError(err)
+	out, err := suite.abi.Methods["unbondingDelegation"].Outputs.Unpack(bz)
+	suite.Assert().NoError(err)
+	return out[0].([]stakingprecompile.IStakingUnbondingDelegationEntry)
+}
+
+func (suite *StakingTestSuite) Test_Staking() {
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	err := suite.App.FundAccount(suite.Ctx, sdk.AccAddress(suite.signerOne.Addr.Bytes()), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+	suite.Require().NoError(err)
+	validatorOne := suite.addValidator()
+	validatorTwo := suite.addValidator()
+
+	// delegate
+	suite.delegate(suite.signerOne, validatorOne, big.NewInt(1000))
+	suite.Assert().EqualValues(big.NewInt(1000), suite.queryDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne))
+	validator := suite.queryValidator(suite.signerOne, validatorOne)
+	suite.Assert().EqualValues(validatorOne, validator.OperatorAddress)
+	suite.Assert().EqualValues("validator", validator.Moniker)
+	suite.Assert().EqualValues(big.NewInt(1000), validator.Tokens)
+
+	// undelegate and cancel a part of the unbonding
+	suite.undelegate(suite.signerOne, validatorOne, big.NewInt(400))
+	suite.Assert().EqualValues(big.NewInt(600), suite.queryDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne))
+	entries := suite.queryUnbondingDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne)
+	suite.Require().Len(entries, 1)
+	suite.Assert().EqualValues(big.NewInt(400), entries[0].Balance)
+	suite.cancelUnbondingDelegation(suite.signerOne, validatorOne, big.NewInt(100), entries[0].CreationHeight)
+	suite.Assert().EqualValues(big.NewInt(700), suite.queryDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne))
+	entries = suite.queryUnbondingDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne)
+	suite.Require().Len(entries, 1)
+	suite.Assert().EqualValues(big.NewInt(300), entries[0].Balance)
+
+	// redelegate
+	suite.redelegate(suite.signerOne, validatorOne, validatorTwo, big.NewInt(200))
+	suite.Assert().EqualValues(big.NewInt(500), suite.queryDelegation(suite.signerOne, suite.signerOne.Addr, validatorOne))
+	suite.Assert().EqualValues(big.NewInt(200), suite.queryDelegation(suite.signerOne, suite.signerOne.Addr, validatorTwo))
+
+	// unknown delegations are empty
+	suite.Assert().Zero(suite.queryDelegation(suite.signerOne, testutil.GenSigner().Addr, validatorOne).Sign())
+	suite.Assert().Len(suite.queryUnbondingDelegation(suite.signerOne, suite.signerOne.Addr, validatorTwo), 0)
+}
+
+func (suite *StakingTestSuite) Test_ReadOnly() {
+	validator := suite.addValidator()
+	input, err := suite.abi.Pack(
+		"delegate",
+		validator,
+		big.NewInt(1),
+	)
+	suite.Assert().NoError(err)
+	_, err = suite.runTx(input, suite.signerOne, 10000000, true)
+	suite.Assert().ErrorIs(err, vm.ErrWriteProtection)
+}
+
+func (suite *StakingTestSuite) Test_DelegateCommit() {
+	denom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	validator := suite.addValidator()
+	bankKeeper := suite.App.GetBankKeeper()
+	// the delegator is a contract receiving value in the tx, so the EVM state commits its balance
+	delegator := suite.DeployForwarder(suite.addr)
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, delegator.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.signerOne.Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	supply := bankKeeper.GetSupply(suite.Ctx, denom)
+	// 10 of the bond denom in the EVM denom
+	value := new(big.Int).Mul(big.NewInt(10), big.NewInt(chaincfg.GasDenomConversionMultiplier))
+
+	input, err := suite.abi.Pack("delegate", validator, big.NewInt(400))
+	suite.Require().NoError(err)
+	res := suite.ApplyMessage(suite.signerOne, delegator, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Assert().EqualValues(sdk.NewInt(610), bankKeeper.GetBalance(suite.Ctx, delegator.Bytes(), denom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(990), bankKeeper.GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), denom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, denom))
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, delegator.Bytes(), sdk.ValAddress(validator.Bytes()))
+	suite.Require().True(found)
+	suite.Assert().EqualValues(sdk.NewDec(400), delegation.Shares)
+
+	// a failed delegation moves nothing
+	input, err = suite.abi.Pack("delegate", validator, big.NewInt(1000))
+	suite.Require().NoError(err)
+	res = suite.ApplyMessage(suite.signerOne, delegator, value, input, 10000000)
+	suite.Require().True(res.Failed())
+	suite.Assert().EqualValues(sdk.NewInt(610), bankKeeper.GetBalance(suite.Ctx, delegator.Bytes(), denom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, denom))
+}
+
+func (suite *StakingTestSuite) Test_DelegateRevert() {
+	denom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	validator := suite.addValidator()
+	bankKeeper := suite.App.GetBankKeeper()
+	// the delegator receives value in the tx and delegates in a call frame which reverts afterwards
+	delegator := suite.DeployRevertingCaller(suite.addr)
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, delegator.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	suite.Require().NoError(suite.App.FundAccount(suite.Ctx, suite.signerOne.Addr.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	supply := bankKeeper.GetSupply(suite.Ctx, denom)
+	value := new(big.Int).Mul(big.NewInt(10), big.NewInt(chaincfg.GasDenomConversionMultiplier))
+
+	input, err := suite.abi.Pack("delegate", validator, big.NewInt(400))
+	suite.Require().NoError(err)
+	res := suite.ApplyMessage(suite.signerOne, delegator, value, input, 10000000)
+	suite.Require().False(res.Failed(), res.VmError)
+	// the delegation is reverted with the frame and the committed balance mints nothing
+	_, found := suite.StakingKeeper.GetDelegation(suite.Ctx, delegator.Bytes(), sdk.ValAddress(validator.Bytes()))
+	suite.Assert().False(found)
+	suite.Assert().EqualValues(sdk.NewInt(1010), bankKeeper.GetBalance(suite.Ctx, delegator.Bytes(), denom).Amount)
+	suite.Assert().EqualValues(sdk.NewInt(990), bankKeeper.GetBalance(suite.Ctx, suite.signerOne.Addr.Bytes(), denom).Amount)
+	suite.Assert().EqualValues(supply, bankKeeper.GetSupply(suite.Ctx, denom))
+}
+
+func TestStakingSuite(t *testing.T) {
+	suite.Run(t, new(StakingTestSuite))
+}
@@ -0,0 +1,85 @@
+package staking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// the delegator of the staking txs is the immediate caller, so contracts delegate their own balance
+
+func (s *StakingPrecompile) Delegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := s.NewMsgDelegate(ctx, args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = s.msgServer().Delegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = s.EmitDelegateEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), msg.Amount.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
+
+func (s *StakingPrecompile) Undelegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := s.NewMsgUndelegate(ctx, args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := s.msgServer().Undelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	completionTime := response.CompletionTime.Unix()
+	err = s.EmitUndelegateEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), msg.Amount.Amount.BigInt(), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) Redelegate(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := s.NewMsgBeginRedelegate(ctx, args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	response, err := s.msgServer().BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	completionTime := response.CompletionTime.Unix()
+	err = s.EmitRedelegateEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), args[1].(common.Address), msg.Amount.Amount.BigInt(), completionTime)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(completionTime)
+}
+
+func (s *StakingPrecompile) CancelUnbondingDelegation(ctx sdk.Context, contract *vm.Contract, stateDB *statedb.StateDB, method *abi.Method, args []interface{}) ([]byte, error) {
+	msg, err := s.NewMsgCancelUnbondingDelegation(ctx, args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+	// execute
+	_, err = s.msgServer().CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+	// emit events
+	err = s.EmitCancelUnbondingDelegationEvent(ctx, stateDB, contract.Caller(), args[0].(common.Address), msg.Amount.Amount.BigInt(), msg.CreationHeight)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack()
+}
@@ -0,0 +1,124 @@
+package staking
+
+import (
+	"fmt"
+	"math/big"
+
+	precopmiles_common "github.com/0glabs/0g-chain/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type IStakingValidator = struct {
+	OperatorAddress   common.Address "json:\"operatorAddress\""
+	Jailed            bool           "json:\"jailed\""
+	Status            uint8          "json:\"status\""
+	Tokens            *big.Int       "json:\"tokens\""
+	DelegatorShares   *big.Int       "json:\"delegatorShares\""
+	Moniker           string         "json:\"moniker\""
+	CommissionRate    *big.Int       "json:\"commissionRate\""
+	MinSelfDelegation *big.Int       "json:\"minSelfDelegation\""
+}
+
+type IStakingUnbondingDelegationEntry = struct {
+	CreationHeight int64    "json:\"creationHeight\""
+	CompletionTime int64    "json:\"completionTime\""
+	InitialBalance *big.Int "json:\"initialBalance\""
+	Balance        *big.Int "json:\"balance\""
+}
+
+// ToAccAddress converts an EVM address to the account address it controls
+func ToAccAddress(addr common.Address) sdk.AccAddress {
+	return sdk.AccAddress(addr.Bytes())
+}
+
+// ToValAddress converts an EVM address to the validator operator address with the same bytes
+func ToValAddress(addr common.Address) sdk.ValAddress {
+	return sdk.ValAddress(addr.Bytes())
+}
+
+// NewIStakingValidator converts the validator, decimals are returned with 18 fractional digits
+func NewIStakingValidator(validator stakingtypes.Validator) (IStakingValidator, error) {
+	operator, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	if err != nil {
+		return IStakingValidator{}, err
+	}
+	return IStakingValidator{
+		OperatorAddress:   common.BytesToAddress(operator),
+		Jailed:            validator.Jailed,
+		Status:            uint8(validator.Status),
+		Tokens:            validator.Tokens.BigInt(),
+		DelegatorShares:   validator.DelegatorShares.BigInt(),
+		Moniker:           validator.Description.Moniker,
+		CommissionRate:    validator.Commission.Rate.BigInt(),
+		MinSelfDelegation: validator.MinSelfDelegation.BigInt(),
+	}, nil
+}
+
+func NewIStakingUnbondingDelegationEntry(entry stakingtypes.UnbondingDelegationEntry) IStakingUnbondingDelegationEntry {
+	return IStakingUnbondingDelegationEntry{
+		CreationHeight: entry.CreationHeight,
+		CompletionTime: entry.CompletionTime.Unix(),
+		InitialBalance: entry.InitialBalance.BigInt(),
+		Balance:        entry.Balance.BigInt(),
+	}
+}
+
+func (s *StakingPrecompile) newCoin(ctx sdk.Context, amount *big.Int) sdk.Coin {
+	return sdk.NewCoin(s.stakingKeeper.BondDenom(ctx), sdk.NewIntFromBigInt(amount))
+}
+
+func (s *StakingPrecompile) NewMsgDelegate(ctx sdk.Context, args []interface{}, delegator common.Address) (*stakingtypes.MsgDelegate, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: ToValAddress(args[0].(common.Address)).String(),
+		Amount:           s.newCoin(ctx, args[1].(*big.Int)),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func (s *StakingPrecompile) NewMsgUndelegate(ctx sdk.Context, args []interface{}, delegator common.Address) (*stakingtypes.MsgUndelegate, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: ToValAddress(args[0].(common.Address)).String(),
+		Amount:           s.newCoin(ctx, args[1].(*big.Int)),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func (s *StakingPrecompile) NewMsgBeginRedelegate(ctx sdk.Context, args []interface{}, delegator common.Address) (*stakingtypes.MsgBeginRedelegate, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    ToAccAddress(delegator).String(),
+		ValidatorSrcAddress: ToValAddress(args[0].(common.Address)).String(),
+		ValidatorDstAddress: ToValAddress(args[1].(common.Address)).String(),
+		Amount:              s.newCoin(ctx, args[2].(*big.Int)),
+	}
+	return msg, msg.ValidateBasic()
+}
+
+func (s *StakingPrecompile) NewMsgCancelUnbondingDelegation(ctx sdk.Context, args []interface{}, delegator common.Address) (*stakingtypes.MsgCancelUnbondingDelegation, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(precopmiles_common.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	msg := &stakingtypes.MsgCancelUnbondingDelegation{
+		DelegatorAddress: ToAccAddress(delegator).String(),
+		ValidatorAddress: ToValAddress(args[0].(common.Address)).String(),
+		Amount:           s.newCoin(ctx, args[1].(*big.Int)),
+		CreationHeight:   args[2].(int64),
+	}
+	return msg, msg.ValidateBasic()
+}
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"math/big"
 	"strings"
 
 	"github.com/0glabs/0g-chain/app"
@@ -13,6 +14,7 @@ import (
 	emtests "github.com/evmos/ethermint/tests"
 	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	"github.com/stretchr/testify/suite"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -81,8 +83,52 @@ func (suite *PrecompileTestSuite) SetupTest() {
 	suite.Addresses = accAddresses
 
 	suite.EvmKeeper = suite.App.GetEvmKeeper()
+	// the EVM balances are read through the evmutil bank keeper, which only serves the chain's EVM denom
+	evmParams := suite.EvmKeeper.GetParams(suite.Ctx)
+	evmParams.EvmDenom = chaincfg.EvmDenom
+	err = suite.EvmKeeper.SetParams(suite.Ctx, evmParams)
+	suite.Assert().NoError(err)
 
 	suite.EthSigner = ethtypes.LatestSignerForChainID(suite.EvmKeeper.ChainID())
 
 	suite.Statedb = statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
 }
+
+// ApplyMessage runs a call through the EVM keeper and commits it, as an ethereum tx does after the ante handlers
+func (suite *PrecompileTestSuite) ApplyMessage(signer *TestSigner, to common.Address, value *big.Int, input []byte, gas uint64) *evmtypes.MsgEthereumTxResponse {
+	msg := ethtypes.NewMessage(signer.Addr, &to, 0, value, gas, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+	res, err := suite.EvmKeeper.ApplyMessage(suite.Ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	return res
+}
+
+// DeployForwarder deploys a contract calling the target with its own calldata and returning or reverting with the
+// returned data, so the precompiles can be tested with a contract caller
+func (suite *PrecompileTestSuite) DeployForwarder(target common.Address) common.Address {
+	code := common.FromHex("0x366000600037600060003660006000")
+	code = append(code, 0x73)
+	code = append(code, target.Bytes()...)
+	code = append(code, common.FromHex("0x5af13d600060003e6033573d6000fd5b3d6000f3")...)
+
+	addr := GenSigner().Addr
+	db := statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
+	db.SetCode(addr, code)
+	suite.Require().NoError(db.Commit())
+	return addr
+}
+
+// DeployRevertingCaller deploys a contract which, called by an account, calls itself with its calldata and ignores
+// the result. Called by itself it calls the target with the calldata and reverts whatever the target returned, so
+// the precompiles can be tested with a call frame reverting after they succeeded while the caller stays dirty.
+func (suite *PrecompileTestSuite) DeployRevertingCaller(target common.Address) common.Address {
+	code := common.FromHex("0x366000600037303314601a57600060003660006000305af15000")
+	code = append(code, common.FromHex("0x5b60006000366000600073")...)
+	code = append(code, target.Bytes()...)
+	code = append(code, common.FromHex("0x5af15060006000fd")...)
+
+	addr := GenSigner().Addr
+	db := statedb.New(suite.Ctx, suite.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.Ctx.HeaderHash().Bytes())))
+	db.SetCode(addr, code)
+	suite.Require().NoError(db.Commit())
+	return addr
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0glabs/0g-chain/crypto/bls"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagBlsKey     = "bls-key"
+	FlagBlsKeyFile = "bls-key-file"
+)
+
+func addBlsKeyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBlsKey, "", "Name of the bn254 key in the keyring used to sign the registration")
+	cmd.Flags().String(FlagBlsKeyFile, "", "Path to a file containing the hex encoded bn254 private key")
+}
+
+// loadBlsKey loads the bn254 signer key from either the keyring or a key file,
+// as selected by the --bls-key and --bls-key-file flags.
+func loadBlsKey(clientCtx client.Context, cmd *cobra.Command) (*bls.PrivKey, error) {
+	keyName, err := cmd.Flags().GetString(FlagBlsKey)
+	if err != nil {
+		return nil, err
+	}
+	keyFile, err := cmd.Flags().GetString(FlagBlsKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case keyName != "" && keyFile != "":
+		return nil, fmt.Errorf("only one of --%s and --%s can be set", FlagBlsKey, FlagBlsKeyFile)
+	case keyFile != "":
+		bz, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return parseBlsKey(string(bz))
+	case keyName != "":
+		return loadBlsKeyFromKeyring(clientCtx, keyName)
+	default:
+		return nil, fmt.Errorf("either --%s or --%s must be set", FlagBlsKey, FlagBlsKeyFile)
+	}
+}
+
+func loadBlsKeyFromKeyring(clientCtx client.Context, name string) (*bls.PrivKey, error) {
+	if clientCtx.Keyring == nil {
+		return nil, fmt.Errorf("keyring is not available")
+	}
+	record, err := clientCtx.Keyring.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	local := record.GetLocal()
+	if local == nil || local.PrivKey == nil {
+		return nil, fmt.Errorf("key %s is not stored locally", name)
+	}
+	priv, ok := local.PrivKey.GetCachedValue().(*bls.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not a %s key", name, bls.KeyType)
+	}
+	return priv, nil
+}
+
+func parseBlsKey(s string) (*bls.PrivKey, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bn254 private key: %w", err)
+	}
+	return bls.PrivKeyFromBytes(bz)
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/0glabs/0g-chain/crypto/bls"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlsKey(t *testing.T) {
+	key, err := parseBlsKey("0x0000000000000000000000000000000000000000000000000000000000000002\n")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), key.Scalar().Int64())
+
+	_, err = parseBlsKey("zz")
+	require.Error(t, err)
+	_, err = parseBlsKey("00")
+	require.Error(t, err)
+	_, err = parseBlsKey(hex.EncodeToString(fr.Modulus().Bytes()))
+	require.Error(t, err)
+}
+
+func TestLoadBlsKeyFromKeyring(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry), func(options *keyring.Options) {
+		options.SupportedAlgos = keyring.SigningAlgoList{hd.Secp256k1, bls.Bn254Algo}
+	})
+	_, _, err := kr.NewMnemonic("bls", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, bls.Bn254Algo)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("secp", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyring(kr)
+	key, err := loadBlsKeyFromKeyring(clientCtx, "bls")
+	require.NoError(t, err)
+	require.Equal(t, bls.KeyType, key.Type())
+
+	_, err = loadBlsKeyFromKeyring(clientCtx, "secp")
+	require.Error(t, err)
+	_, err = loadBlsKeyFromKeyring(clientCtx, "missing")
+	require.Error(t, err)
+}
+
+func TestBlsKeySign(t *testing.T) {
+	key, err := bls.PrivKeyFromBytes(big.NewInt(12345).Bytes())
+	require.NoError(t, err)
+	pubkey := key.PubKey().(*bls.PubKey)
+
+	account := common.HexToAddress("0x9685C4EB29309820CDC62663CC6CC82F3D42E964")
+	signer := &types.Signer{
+		Account:  "9685c4eb29309820cdc62663cc6cc82f3d42e964",
+		PubkeyG1: pubkey.G1(),
+		PubkeyG2: pubkey.G2(),
+	}
+	require.NoError(t, signer.Validate())
+
+	hash := types.PubkeyRegistrationHash(account, big.NewInt(8888))
+	require.True(t, signer.ValidateSignature(hash, bn254util.DeserializeG1(key.SignHash(hash))))
+
+	hash = types.EpochRegistrationHash(account, 1, big.NewInt(8888))
+	require.True(t, signer.ValidateSignature(hash, bn254util.DeserializeG1(key.SignHash(hash))))
+	require.False(t, signer.ValidateSignature(types.EpochRegistrationHash(account, 2, big.NewInt(8888)), bn254util.DeserializeG1(key.SignHash(hash))))
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -23,6 +24,14 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetEpochNumber(),
+		GetEpochStake(),
+		GetSigners(),
+		GetEpochRegistrations(),
+		GetEpochQuorums(),
+		GetEpochSeed(),
+		GetEpochParams(),
+		GetPendingRewards(),
+		GetSignerOperator(),
 	)
 
 	return cmd
@@ -55,3 +64,279 @@ func GetEpochNumber() *cobra.Command {
 
 	return cmd
 }
+
+func GetEpochStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-stake [epoch-number] [account]",
+		Short: "Query the bonded tokens snapshot of a signer in an epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEpochStakeRequest{
+				EpochNumber: epochNumber,
+				Account:     args[1],
+			}
+			res, err := queryClient.EpochStake(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signers",
+		Short: "Query all registered signers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySignersRequest{Pagination: pageReq}
+			res, err := queryClient.Signers(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "signers")
+
+	return cmd
+}
+
+func GetEpochRegistrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-registrations [epoch-number]",
+		Short: "Query the signer registrations of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEpochRegistrationsRequest{
+				EpochNumber: epochNumber,
+				Pagination:  pageReq,
+			}
+			res, err := queryClient.EpochRegistrations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch registrations")
+
+	return cmd
+}
+
+func GetEpochQuorums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-quorums [epoch-number]",
+		Short: "Query the quorums of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEpochQuorumsRequest{
+				EpochNumber: epochNumber,
+				Pagination:  pageReq,
+			}
+			res, err := queryClient.EpochQuorums(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch quorums")
+
+	return cmd
+}
+
+func GetEpochSeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-seed [epoch-number]",
+		Short: "Query the quorum sampling seed of an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEpochSeedRequest{EpochNumber: epochNumber}
+			res, err := queryClient.EpochSeed(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%x\n", res.Seed))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetEpochParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-params [epoch-number]",
+		Short: "Query the params in force when the quorums of an epoch were generated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryEpochParamsRequest{EpochNumber: epochNumber}
+			res, err := queryClient.EpochParams(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards [account]",
+		Short: "Query the rewards allocated to a signer and not claimed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingRewardsRequest{Account: args[0]}
+			res, err := queryClient.PendingRewards(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetSignerOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-operator [account]",
+		Short: "Query the account whose delegations back a signer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QuerySignerOperatorRequest{Account: args[0]}
+			res, err := queryClient.SignerOperator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,13 +1,25 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
+	"github.com/0glabs/0g-chain/crypto/bls"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+	etherminttypes "github.com/evmos/ethermint/types"
 	"github.com/spf13/cobra"
 )
 
+// FlagOperator defines the operator account backing a signer with its delegations
+const FlagOperator = "operator"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,6 +29,259 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand()
+
+	cmds := []*cobra.Command{
+		getCmdRegisterSigner(),
+		getCmdUpdateSocket(),
+		getCmdRegisterNextEpoch(),
+		getCmdClaimRewards(),
+		getCmdAuthorizeSigner(),
+	}
+
+	for _, c := range cmds {
+		flags.AddTxFlagsToCmd(c)
+	}
+
+	cmd.AddCommand(cmds...)
+	cmd.AddCommand(getCmdShowBlsKey())
+	return cmd
+}
+
+// getCmdShowBlsKey returns the command to export the G1 and G2 public keys of a bn254 keyring key.
+func getCmdShowBlsKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-bls-key [name]",
+		Short: "Show the G1 and G2 public keys of a bn254 key in the keyring",
+		Long: fmt.Sprintf(`Show the G1 and G2 public keys of a bn254 key in the keyring.
+
+A bn254 key can be created with: %s keys add [name] --algo %s`, version.AppName, bls.KeyType),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Keyring == nil {
+				return fmt.Errorf("keyring is not available")
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			pk, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			pubkey, ok := pk.(*bls.PubKey)
+			if !ok {
+				return fmt.Errorf("key %s is not a %s key", args[0], bls.KeyType)
+			}
+
+			return clientCtx.PrintObjectLegacy(map[string]string{
+				"pubkey_g1": hex.EncodeToString(pubkey.G1()),
+				"pubkey_g2": hex.EncodeToString(pubkey.G2()),
+			})
+		},
+	}
+
+	flags.AddKeyringFlags(cmd.Flags())
+	cmd.Flags().String(flags.FlagOutput, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// getCmdRegisterSigner returns the command to register the sender as a DA signer.
+func getCmdRegisterSigner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-signer [socket]",
+		Short: "Register the sender as a DA signer",
+		Long: `Register the sender as a DA signer with the given socket.
+
+The bn254 key is loaded from the keyring (--bls-key) or from a file holding the hex encoded
+private key (--bls-key-file), and is used to sign the pubkey registration hash locally.
+If the sender was authorized by an operator, giving the operator by --operator accepts the
+authorization and its delegations back the signer.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s register-signer 0.0.0.0:1234 --bls-key-file bls.key --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := loadBlsKey(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := etherminttypes.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			operator, err := cmd.Flags().GetString(FlagOperator)
+			if err != nil {
+				return err
+			}
+
+			pubkey := key.PubKey().(*bls.PubKey)
+			from := clientCtx.GetFromAddress()
+			hash := types.PubkeyRegistrationHash(common.BytesToAddress(from), chainID)
+			msg := &types.MsgRegisterSigner{
+				Signer: &types.Signer{
+					Account:  hex.EncodeToString(from),
+					Socket:   args[0],
+					PubkeyG1: pubkey.G1(),
+					PubkeyG2: pubkey.G2(),
+				},
+				Signature: key.SignHash(hash),
+				Operator:  strings.TrimPrefix(strings.ToLower(operator), "0x"),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBlsKeyFlags(cmd)
+	cmd.Flags().String(FlagOperator, "", "Hex address of the operator which authorized the sender as its signer")
+
+	return cmd
+}
+
+// getCmdUpdateSocket returns the command to update the socket of a registered signer.
+func getCmdUpdateSocket() *cobra.Command {
+	return &cobra.Command{
+		Use:     "update-socket [socket]",
+		Short:   "Update the socket of the sender's signer",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s update-socket 0.0.0.0:1234 --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateSocket{
+				Account: hex.EncodeToString(clientCtx.GetFromAddress()),
+				Socket:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// getCmdRegisterNextEpoch returns the command to register the sender's signer for the next epoch.
+func getCmdRegisterNextEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-next-epoch",
+		Short: "Register the sender's signer for the next epoch",
+		Long: `Register the sender's signer for the next epoch.
+
+The current epoch number is queried from the node and the epoch registration hash of the
+following epoch is signed locally with the bn254 key given by --bls-key or --bls-key-file.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s tx %s register-next-epoch --bls-key mybls --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := loadBlsKey(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := etherminttypes.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EpochNumber(context.Background(), &types.QueryEpochNumberRequest{})
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			hash := types.EpochRegistrationHash(common.BytesToAddress(from), res.EpochNumber+1, chainID)
+			msg := &types.MsgRegisterNextEpoch{
+				Account:   hex.EncodeToString(from),
+				Signature: key.SignHash(hash),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addBlsKeyFlags(cmd)
+
 	return cmd
 }
+
+// getCmdClaimRewards returns the command to withdraw the epoch rewards of the sender's signer.
+func getCmdClaimRewards() *cobra.Command {
+	return &cobra.Command{
+		Use:     "claim-rewards",
+		Short:   "Withdraw the epoch rewards allocated to the sender's signer",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s tx %s claim-rewards --from mykey", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimRewards{
+				Account: hex.EncodeToString(clientCtx.GetFromAddress()),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// getCmdAuthorizeSigner returns the command to back a signer address with the delegations of the sender.
+func getCmdAuthorizeSigner() *cobra.Command {
+	return &cobra.Command{
+		Use:   "authorize-signer [signer]",
+		Short: "Authorize a signer address to sign on the delegations of the sender",
+		Long: `Authorize a signer address to sign on the delegations of the sender.
+
+The sender stays the staking account while the signer holds the bn254 key and socket on the DA node,
+the signer accepts by registering with --operator set to the sender. Once accepted, a new signer
+replaces the previous one, which falls back to its own delegations.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s authorize-signer 0x0000000000000000000000000000000000000001 --from operator", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAuthorizeSigner{
+				Operator: hex.EncodeToString(clientCtx.GetFromAddress()),
+				Signer:   strings.TrimPrefix(strings.ToLower(args[0]), "0x"),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
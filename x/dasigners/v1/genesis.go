@@ -3,6 +3,7 @@ package dasigners
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
@@ -15,15 +16,74 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, gs types.GenesisState) {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
 	keeper.SetEpochNumber(ctx, gs.EpochNumber)
+	// exports made before the epoch anchors were introduced follow the fixed height / epoch_blocks schedule
+	epochStartHeight := gs.EpochStartHeight
+	if epochStartHeight == 0 {
+		epochStartHeight = gs.EpochNumber * gs.Params.EpochBlocks
+	}
+	keeper.SetEpochStartHeight(ctx, gs.EpochNumber, epochStartHeight)
 	for _, signer := range gs.Signers {
 		if err := keeper.SetSigner(ctx, *signer); err != nil {
 			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
 		}
 	}
-	for epoch, quorums := range gs.QuorumsByEpoch {
-		keeper.SetEpochQuorums(ctx, uint64(epoch), *quorums)
+	keeper.SetEarliestEpoch(ctx, gs.EarliestEpoch)
+	for i, quorums := range gs.QuorumsByEpoch {
+		keeper.SetEpochQuorums(ctx, gs.EarliestEpoch+uint64(i), *quorums)
+	}
+	for _, exit := range gs.SignerExits {
+		if err := keeper.SetSignerExit(ctx, exit.Account, exit.ExitEpoch); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, rotation := range gs.SignerKeyRotations {
+		if err := keeper.SetSignerKeyRotation(ctx, *rotation); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, previousKey := range gs.PreviousSignerKeys {
+		if err := keeper.SetPreviousSignerKey(ctx, *previousKey); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, jail := range gs.JailedSigners {
+		if err := keeper.SetJailedSigner(ctx, jail.Account, jail.JailEpoch); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, seed := range gs.EpochSeeds {
+		keeper.SetEpochSeed(ctx, seed.EpochNumber, seed.Seed)
+	}
+	for _, epochParams := range gs.EpochParams {
+		keeper.SetEpochParams(ctx, epochParams.EpochNumber, epochParams.Params)
+	}
+	for _, signerOperator := range gs.SignerOperators {
+		if err := keeper.SetSignerOperator(ctx, signerOperator.Account, signerOperator.Operator); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, pending := range gs.PendingAuthorizations {
+		if err := keeper.SetPendingAuthorization(ctx, pending.Operator, pending.Account); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	for _, stake := range gs.EpochStakes {
+		if err := keeper.SetEpochStake(ctx, stake.EpochNumber, stake.Account, stake.Bonded); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+	}
+	outstanding := sdk.ZeroInt()
+	for _, rewards := range gs.SignerRewards {
+		if err := keeper.SetSignerRewards(ctx, rewards.Account, rewards.Amount); err != nil {
+			panic(fmt.Sprintf("failed to write genesis state into store: %s", err))
+		}
+		outstanding = outstanding.Add(rewards.Amount)
 	}
+	keeper.SetOutstandingRewards(ctx, outstanding)
 	keeper.SetParams(ctx, gs.Params)
+	if gs.PendingParams != nil {
+		keeper.SetPendingParams(ctx, *gs.PendingParams)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,15 +98,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		signers = append(signers, &signer)
 		return false
 	})
+	// epochs before the earliest one are pruned
+	earliestEpoch := keeper.GetEarliestEpoch(ctx)
 	epochQuorums := make([]*types.Quorums, 0)
-	for i := 0; i <= int(epochNumber); i += 1 {
-		quorumCnt, err := keeper.GetQuorumCount(ctx, uint64(i))
+	for epoch := earliestEpoch; epoch <= epochNumber; epoch += 1 {
+		quorumCnt, err := keeper.GetQuorumCount(ctx, epoch)
 		if err != nil {
 			panic("historical quorums not found")
 		}
 		quorums := make([]*types.Quorum, quorumCnt)
 		for quorumId := uint64(0); quorumId < quorumCnt; quorumId += 1 {
-			quorum, err := keeper.GetEpochQuorum(ctx, uint64(i), quorumId)
+			quorum, err := keeper.GetEpochQuorum(ctx, epoch, quorumId)
 			if err != nil {
 				panic("failed to load historical quorum")
 			}
@@ -54,5 +116,63 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		}
 		epochQuorums = append(epochQuorums, &types.Quorums{Quorums: quorums})
 	}
-	return types.NewGenesisState(params, epochNumber, signers, epochQuorums)
+	signerExits := make([]*types.SignerExit, 0)
+	keeper.IterateSignerExits(ctx, func(account string, exitEpoch uint64) (stop bool) {
+		signerExits = append(signerExits, &types.SignerExit{Account: account, ExitEpoch: exitEpoch})
+		return false
+	})
+	gs := types.NewGenesisState(params, epochNumber, signers, epochQuorums, signerExits)
+	gs.EarliestEpoch = earliestEpoch
+	if epochStartHeight, found := keeper.GetEpochStartHeight(ctx, epochNumber); found {
+		gs.EpochStartHeight = epochStartHeight
+	}
+	if pendingParams, found := keeper.GetPendingParams(ctx); found {
+		gs.PendingParams = &pendingParams
+	}
+	gs.SignerKeyRotations = make([]*types.SignerKeyRotation, 0)
+	keeper.IterateSignerKeyRotations(ctx, func(rotation types.SignerKeyRotation) (stop bool) {
+		gs.SignerKeyRotations = append(gs.SignerKeyRotations, &rotation)
+		return false
+	})
+	gs.PreviousSignerKeys = make([]*types.PreviousSignerKey, 0)
+	keeper.IteratePreviousSignerKeys(ctx, func(previousKey types.PreviousSignerKey) (stop bool) {
+		gs.PreviousSignerKeys = append(gs.PreviousSignerKeys, &previousKey)
+		return false
+	})
+	gs.JailedSigners = make([]*types.JailedSigner, 0)
+	keeper.IterateJailedSigners(ctx, func(account string, jailEpoch uint64) (stop bool) {
+		gs.JailedSigners = append(gs.JailedSigners, &types.JailedSigner{Account: account, JailEpoch: jailEpoch})
+		return false
+	})
+	gs.EpochSeeds = make([]*types.EpochSeed, 0)
+	keeper.IterateEpochSeeds(ctx, func(epoch uint64, seed []byte) (stop bool) {
+		gs.EpochSeeds = append(gs.EpochSeeds, &types.EpochSeed{EpochNumber: epoch, Seed: seed})
+		return false
+	})
+	gs.EpochParams = make([]*types.EpochParams, 0)
+	keeper.IterateEpochParams(ctx, func(epoch uint64, params types.Params) (stop bool) {
+		gs.EpochParams = append(gs.EpochParams, &types.EpochParams{EpochNumber: epoch, Params: params})
+		return false
+	})
+	gs.SignerOperators = make([]*types.SignerOperator, 0)
+	keeper.IterateSignerOperators(ctx, func(account string, operator string) (stop bool) {
+		gs.SignerOperators = append(gs.SignerOperators, &types.SignerOperator{Account: account, Operator: operator})
+		return false
+	})
+	gs.PendingAuthorizations = make([]*types.SignerOperator, 0)
+	keeper.IteratePendingAuthorizations(ctx, func(operator string, account string) (stop bool) {
+		gs.PendingAuthorizations = append(gs.PendingAuthorizations, &types.SignerOperator{Account: account, Operator: operator})
+		return false
+	})
+	gs.SignerRewards = make([]*types.SignerRewards, 0)
+	keeper.IterateSignerRewards(ctx, func(account string, amount math.Int) (stop bool) {
+		gs.SignerRewards = append(gs.SignerRewards, &types.SignerRewards{Account: account, Amount: amount})
+		return false
+	})
+	gs.EpochStakes = make([]*types.EpochStake, 0)
+	keeper.IterateEpochStakes(ctx, func(epoch uint64, account string, bonded math.Int) (stop bool) {
+		gs.EpochStakes = append(gs.EpochStakes, &types.EpochStake{EpochNumber: epoch, Account: account, Bonded: bonded})
+		return false
+	})
+	return gs
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/0glabs/0g-chain/app"
 	"github.com/0glabs/0g-chain/x/dasigners/v1"
@@ -38,6 +39,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
+				GasSchedule:       types.DefaultGasSchedule(),
 			}, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
@@ -45,7 +47,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerExit{}),
 			expectPass: true,
 		},
 		{
@@ -56,6 +58,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
+				GasSchedule:       types.DefaultGasSchedule(),
 			}, 0, []*types.Signer{{
 				Account:  "0x0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
@@ -63,7 +66,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0x0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerExit{}),
 			expectPass: false,
 		},
 		{
@@ -74,6 +77,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
+				GasSchedule:       types.DefaultGasSchedule(),
 			}, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
@@ -81,7 +85,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				PubkeyG2: make([]byte, 128),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerExit{}),
 			expectPass: false,
 		},
 		{
@@ -92,6 +96,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
+				GasSchedule:       types.DefaultGasSchedule(),
 			}, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
@@ -99,7 +104,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				PubkeyG2: make([]byte, 129),
 			}}, []*types.Quorums{{
 				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
-			}}),
+			}}, []*types.SignerExit{}),
 			expectPass: false,
 		},
 		{
@@ -110,12 +115,379 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 				MaxQuorums:        10,
 				EpochBlocks:       5760,
 				EncodedSlices:     1,
+				GasSchedule:       types.DefaultGasSchedule(),
+			}, 0, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{}, []*types.SignerExit{}),
+			expectPass: false,
+		},
+		{
+			name: "normal-signer-exit",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:      10,
+				MaxVotesPerSigner:  1024,
+				MaxQuorums:         10,
+				EpochBlocks:        5760,
+				EncodedSlices:      1,
+				ExitCooldownEpochs: 2,
+				GasSchedule:        types.DefaultGasSchedule(),
+			}, 1, []*types.Signer{{
+				Account:  "0000000000000000000000000000000000000001",
+				Socket:   "0.0.0.0:1234",
+				PubkeyG1: make([]byte, 64),
+				PubkeyG2: make([]byte, 128),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000002"}}},
+			}, {
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerExit{{
+				Account:   "0000000000000000000000000000000000000001",
+				ExitEpoch: 4,
+			}}),
+			expectPass: true,
+		},
+		{
+			name: "normal-pending-params",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				pendingParams := gs.Params
+				pendingParams.EncodedSlices = 1024
+				gs.PendingParams = &pendingParams
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "invalid pending params",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				pendingParams := gs.Params
+				pendingParams.EpochBlocks = 0
+				gs.PendingParams = &pendingParams
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "normal-signer-key-rotation",
+			genState: func() *types.GenesisState {
+				gs := types.NewGenesisState(types.DefaultGenesisState().Params, 1, []*types.Signer{{
+					Account:  "0000000000000000000000000000000000000001",
+					Socket:   "0.0.0.0:1234",
+					PubkeyG1: make([]byte, 64),
+					PubkeyG2: make([]byte, 128),
+				}}, []*types.Quorums{{
+					Quorums: []*types.Quorum{},
+				}, {
+					Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+				}}, []*types.SignerExit{})
+				gs.SignerKeyRotations = []*types.SignerKeyRotation{{
+					Account:        "0000000000000000000000000000000000000001",
+					Nonce:          2,
+					PubkeyG1:       make([]byte, 64),
+					PubkeyG2:       make([]byte, 128),
+					EffectiveEpoch: 2,
+				}}
+				gs.PreviousSignerKeys = []*types.PreviousSignerKey{{
+					Account:   "0000000000000000000000000000000000000001",
+					LastEpoch: 0,
+					PubkeyG1:  make([]byte, 64),
+					PubkeyG2:  make([]byte, 128),
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "epoch seeds",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochNumber = 1
+				gs.QuorumsByEpoch = append(gs.QuorumsByEpoch, &types.Quorums{Quorums: []*types.Quorum{}})
+				gs.EpochSeeds = []*types.EpochSeed{{
+					EpochNumber: 1,
+					Seed:        make([]byte, 32),
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "seed of future epoch",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochSeeds = []*types.EpochSeed{{
+					EpochNumber: 1,
+					Seed:        make([]byte, 32),
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "pruned history",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochNumber = 5
+				gs.EarliestEpoch = 4
+				gs.QuorumsByEpoch = []*types.Quorums{{Quorums: []*types.Quorum{}}, {Quorums: []*types.Quorum{}}}
+				gs.EpochSeeds = []*types.EpochSeed{{
+					EpochNumber: 5,
+					Seed:        make([]byte, 32),
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "seed of pruned epoch",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochNumber = 5
+				gs.EarliestEpoch = 5
+				gs.EpochSeeds = []*types.EpochSeed{{
+					EpochNumber: 4,
+					Seed:        make([]byte, 32),
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "epoch params",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochNumber = 1
+				gs.QuorumsByEpoch = append(gs.QuorumsByEpoch, &types.Quorums{Quorums: []*types.Quorum{}})
+				epochParams := gs.Params
+				epochParams.EncodedSlices = 1024
+				gs.EpochParams = []*types.EpochParams{{
+					EpochNumber: 1,
+					Params:      epochParams,
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "params of pruned epoch",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochNumber = 5
+				gs.EarliestEpoch = 5
+				gs.EpochParams = []*types.EpochParams{{
+					EpochNumber: 4,
+					Params:      gs.Params,
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "invalid epoch params",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochParams = []*types.EpochParams{{
+					EpochNumber: 0,
+					Params:      types.Params{},
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "earliest epoch after current epoch",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EarliestEpoch = 1
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "signer rewards",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SignerRewards = []*types.SignerRewards{{
+					Account: "0000000000000000000000000000000000000001",
+					Amount:  sdk.NewInt(100),
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "zero signer rewards",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SignerRewards = []*types.SignerRewards{{
+					Account: "0000000000000000000000000000000000000001",
+					Amount:  sdk.ZeroInt(),
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "epoch stakes",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochStakes = []*types.EpochStake{{
+					EpochNumber: 0,
+					Account:     "0000000000000000000000000000000000000001",
+					Bonded:      sdk.NewInt(100),
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "stake of future epoch",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochStakes = []*types.EpochStake{{
+					EpochNumber: 1,
+					Account:     "0000000000000000000000000000000000000001",
+					Bonded:      sdk.NewInt(100),
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "duplicate epoch stake",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.EpochStakes = []*types.EpochStake{
+					{Account: "0000000000000000000000000000000000000001", Bonded: sdk.NewInt(100)},
+					{Account: "0000000000000000000000000000000000000001", Bonded: sdk.NewInt(200)},
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "jailed signers",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.JailedSigners = []*types.JailedSigner{{
+					Account:   "0000000000000000000000000000000000000001",
+					JailEpoch: 0,
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "duplicate jailed signer",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.JailedSigners = []*types.JailedSigner{
+					{Account: "0000000000000000000000000000000000000001"},
+					{Account: "0000000000000000000000000000000000000001"},
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "signer operators",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SignerOperators = []*types.SignerOperator{{
+					Account:  "0000000000000000000000000000000000000001",
+					Operator: "0000000000000000000000000000000000000002",
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "pending authorizations",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.PendingAuthorizations = []*types.SignerOperator{{
+					Account:  "0000000000000000000000000000000000000001",
+					Operator: "0000000000000000000000000000000000000002",
+				}}
+				return gs
+			}(),
+			expectPass: true,
+		},
+		{
+			name: "duplicate pending authorization",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.PendingAuthorizations = []*types.SignerOperator{
+					{Account: "0000000000000000000000000000000000000001", Operator: "0000000000000000000000000000000000000003"},
+					{Account: "0000000000000000000000000000000000000002", Operator: "0000000000000000000000000000000000000003"},
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "operator backing several signers",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SignerOperators = []*types.SignerOperator{
+					{Account: "0000000000000000000000000000000000000001", Operator: "0000000000000000000000000000000000000003"},
+					{Account: "0000000000000000000000000000000000000002", Operator: "0000000000000000000000000000000000000003"},
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "signer is an operator",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.SignerOperators = []*types.SignerOperator{
+					{Account: "0000000000000000000000000000000000000001", Operator: "0000000000000000000000000000000000000002"},
+					{Account: "0000000000000000000000000000000000000002", Operator: "0000000000000000000000000000000000000003"},
+				}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "previous signer key without rotation",
+			genState: func() *types.GenesisState {
+				gs := types.DefaultGenesisState()
+				gs.PreviousSignerKeys = []*types.PreviousSignerKey{{
+					Account:   "0000000000000000000000000000000000000001",
+					LastEpoch: 0,
+					PubkeyG1:  make([]byte, 64),
+					PubkeyG2:  make([]byte, 128),
+				}}
+				return gs
+			}(),
+			expectPass: false,
+		},
+		{
+			name: "exiting signer missing",
+			genState: types.NewGenesisState(types.Params{
+				TokensPerVote:      10,
+				MaxVotesPerSigner:  1024,
+				MaxQuorums:         10,
+				EpochBlocks:        5760,
+				EncodedSlices:      1,
+				ExitCooldownEpochs: 2,
+				GasSchedule:        types.DefaultGasSchedule(),
 			}, 0, []*types.Signer{{
 				Account:  "0000000000000000000000000000000000000001",
 				Socket:   "0.0.0.0:1234",
 				PubkeyG1: make([]byte, 64),
 				PubkeyG2: make([]byte, 128),
-			}}, []*types.Quorums{}),
+			}}, []*types.Quorums{{
+				Quorums: []*types.Quorum{{Signers: []string{"0000000000000000000000000000000000000001"}}},
+			}}, []*types.SignerExit{{
+				Account:   "0000000000000000000000000000000000000002",
+				ExitEpoch: 4,
+			}}),
 			expectPass: false,
 		},
 	}
@@ -140,7 +512,12 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 
 			// Check
 			if tc.expectPass {
-				expectedJson, err := suite.App.AppCodec().MarshalJSON(tc.genState)
+				// the start height of the current epoch is exported, falling back to the fixed schedule
+				expected := *tc.genState
+				if expected.EpochStartHeight == 0 {
+					expected.EpochStartHeight = expected.EpochNumber * expected.Params.EpochBlocks
+				}
+				expectedJson, err := suite.App.AppCodec().MarshalJSON(&expected)
 				suite.Require().NoError(err)
 				actualJson, err := suite.App.AppCodec().MarshalJSON(exportedGenState)
 				suite.Require().NoError(err)
@@ -150,6 +527,22 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestInitGenesisEpochStartHeight() {
+	suite.App = app.NewTestApp()
+	suite.Keeper = suite.App.GetDASignersKeeper()
+	suite.Ctx = suite.App.NewContext(true, tmproto.Header{})
+
+	// an export without the start height of the current epoch falls back to the fixed schedule
+	gs := types.DefaultGenesisState()
+	gs.EpochNumber = 3
+	gs.EarliestEpoch = 3
+	dasigners.InitGenesis(suite.Ctx, suite.Keeper, *gs)
+	height, found := suite.Keeper.GetEpochStartHeight(suite.Ctx, 3)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(3*gs.Params.EpochBlocks, height)
+	suite.Assert().EqualValues(3*gs.Params.EpochBlocks, dasigners.ExportGenesis(suite.Ctx, suite.Keeper).EpochStartHeight)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -1,10 +1,8 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
 	"math/big"
-	"sort"
 
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -17,6 +15,12 @@ type Ballot struct {
 	content []byte
 }
 
+// epochSeed derives the quorum sampling seed of an epoch from the hash of the block closing the
+// registration, which is unknown to signers when they register
+func epochSeed(ctx sdk.Context, epoch uint64) []byte {
+	return crypto.Keccak256(ctx.HeaderHash(), sdk.Uint64ToBigEndian(epoch))
+}
+
 // generateOneEpoch generate one epoch and returns true if there is a new epoch generated
 func (k Keeper) generateOneEpoch(ctx sdk.Context) bool {
 	epochNumber, err := k.GetEpochNumber(ctx)
@@ -25,16 +29,16 @@ func (k Keeper) generateOneEpoch(ctx sdk.Context) bool {
 		panic(err)
 	}
 	params := k.GetParams(ctx)
-	expectedEpoch := uint64(ctx.BlockHeight()) / params.EpochBlocks
-	if expectedEpoch == epochNumber {
+	// the next boundary is derived from the start of the current epoch, so epoch_blocks can change between epochs
+	nextEpochStart := k.getEpochAnchor(ctx, epochNumber, params) + params.EpochBlocks
+	if uint64(ctx.BlockHeight()) < nextEpochStart {
 		return false
 	}
-	if expectedEpoch < epochNumber {
-		panic("block height is not continuous")
-	}
-	expectedEpoch = epochNumber + 1
+	expectedEpoch := epochNumber + 1
 	// new epoch
 	k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] generating epoch %v", expectedEpoch))
+	seed := epochSeed(ctx, expectedEpoch)
+	k.SetEpochSeed(ctx, expectedEpoch, seed)
 	registrations := []Ballot{}
 	k.IterateRegistrations(ctx, expectedEpoch, func(account string, signature []byte) (stop bool) {
 		registrations = append(registrations, Ballot{
@@ -43,78 +47,131 @@ func (k Keeper) generateOneEpoch(ctx sdk.Context) bool {
 		})
 		return false
 	})
-	ballots := []Ballot{}
+	candidates := []signerBallots{}
+	shares := []rewardShare{}
 	tokensPerVote := sdk.NewIntFromUint64(params.TokensPerVote)
 	for _, registration := range registrations {
-		// get validator
-		accAddr, err := sdk.AccAddressFromHexUnsafe(registration.account)
+		// snapshot the bonded tokens of the signer at registration close
+		bonded, err := k.GetSignerStake(ctx, registration.account)
 		if err != nil {
 			k.Logger(ctx).Error("[BeginBlock] invalid account")
 			continue
 		}
-		bonded := k.GetDelegatorBonded(ctx, accAddr)
+		if err := k.SetEpochStake(ctx, expectedEpoch, registration.account, bonded); err != nil {
+			panic(err)
+		}
+		if err := k.SetSignerStake(ctx, registration.account, bonded); err != nil {
+			panic(err)
+		}
 		num := bonded.Quo(BondedConversionRate).Quo(tokensPerVote).Abs().BigInt()
 		if num.Cmp(big.NewInt(int64(params.MaxVotesPerSigner))) > 0 {
 			num = big.NewInt(int64(params.MaxVotesPerSigner))
 		}
-		content := registration.content
+		// mix the seed into the first ballot so signers cannot predict their placement at registration
+		content := crypto.Keccak256(seed, registration.content)
 		ballotNum := num.Int64()
-		for j := 0; j < int(ballotNum); j += 1 {
-			ballots = append(ballots, Ballot{
-				account: registration.account,
-				content: content,
-			})
-			content = crypto.Keccak256(content)
-		}
+		shares = append(shares, rewardShare{account: registration.account, ballots: ballotNum})
+		candidates = append(candidates, signerBallots{account: registration.account, num: ballotNum, first: content})
 	}
-	sort.Slice(ballots, func(i, j int) bool {
-		return bytes.Compare(ballots[i].content, ballots[j].content) < 0
-	})
-
-	quorums := types.Quorums{
-		Quorums: make([]*types.Quorum, 0),
-	}
-	if len(ballots) >= int(params.EncodedSlices) {
-		for i := 0; i+int(params.EncodedSlices) <= len(ballots); i += int(params.EncodedSlices) {
-			if int(params.MaxQuorums) <= len(quorums.Quorums) {
-				break
-			}
-			quorum := types.Quorum{
-				Signers: make([]string, params.EncodedSlices),
-			}
-			for j := 0; j < int(params.EncodedSlices); j += 1 {
-				quorum.Signers[j] = ballots[i+j].account
-			}
-			quorums.Quorums = append(quorums.Quorums, &quorum)
-		}
-		if len(ballots)%int(params.EncodedSlices) != 0 && int(params.MaxQuorums) > len(quorums.Quorums) {
-			quorum := types.Quorum{
-				Signers: make([]string, 0),
-			}
-			for j := len(ballots) - int(params.EncodedSlices); j < len(ballots); j += 1 {
-				quorum.Signers = append(quorum.Signers, ballots[j].account)
-			}
-			quorums.Quorums = append(quorums.Quorums, &quorum)
+	// only the smallest ballots are kept while streaming the ballot chains, so memory does not grow with the signer set
+	quorums := formQuorums(selectBallots(candidates, params), params)
+	// degenerate quorums are not published, the previous quorums keep serving until enough signers take part,
+	// except the ones with a jailed or exited signer
+	if reason := checkQuorumParticipation(quorums, params); reason != "" {
+		previous, err := k.GetEpochQuorums(ctx, epochNumber)
+		if err != nil {
+			panic(err)
 		}
-	} else if len(ballots) > 0 {
-		quorum := types.Quorum{
-			Signers: make([]string, params.EncodedSlices),
+		k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] carrying quorums of epoch %v forward: %v", epochNumber, reason))
+		var dropped uint64
+		quorums, dropped, err = k.carriedQuorums(ctx, previous, expectedEpoch)
+		if err != nil {
+			panic(err)
 		}
-		n := len(ballots)
-		for i := 0; i < int(params.EncodedSlices); i += 1 {
-			quorum.Signers[i] = ballots[i%n].account
+		// the registered signers do not serve the epoch, the reward pool is left for the next one
+		shares = nil
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventQuorumsCarriedForward{
+			Epoch:          expectedEpoch,
+			FromEpoch:      epochNumber,
+			Reason:         reason,
+			DroppedQuorums: dropped,
+		}); err != nil {
+			panic(err)
 		}
-		quorums.Quorums = append(quorums.Quorums, &quorum)
 	}
 
 	// save to store
 	k.SetEpochQuorums(ctx, expectedEpoch, quorums)
+	k.SetEpochParams(ctx, expectedEpoch, params)
 	k.SetEpochNumber(ctx, expectedEpoch)
+	k.SetEpochStartHeight(ctx, expectedEpoch, nextEpochStart)
+	k.applyPendingParams(ctx)
+	if err := k.applySignerKeyRotations(ctx, expectedEpoch); err != nil {
+		panic(err)
+	}
+	if err := k.removeExitedSigners(ctx, expectedEpoch, quorums); err != nil {
+		panic(err)
+	}
+	if err := k.allocateEpochRewards(ctx, expectedEpoch, shares); err != nil {
+		panic(err)
+	}
+	if k.hooks != nil {
+		if err := k.hooks.AfterEpochGenerated(ctx, expectedEpoch, quorums); err != nil {
+			panic(err)
+		}
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNewEpoch{
+		Epoch:       expectedEpoch,
+		QuorumCount: uint64(len(quorums.Quorums)),
+		Seed:        seed,
+	}); err != nil {
+		panic(err)
+	}
 	k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] epoch %v generated, with %v quorums", expectedEpoch, len(quorums.Quorums)))
 	return true
 }
 
+// carriedQuorums returns the quorums which keep serving the epoch, the quorums with a jailed signer or a signer whose
+// exit is due are dropped, so neither misbehaving nor exited signers are kept in service by the carry-forward
+func (k Keeper) carriedQuorums(ctx sdk.Context, previous types.Quorums, epoch uint64) (types.Quorums, uint64, error) {
+	carried := types.Quorums{Quorums: make([]*types.Quorum, 0, len(previous.Quorums))}
+	inactive := make(map[string]bool)
+	for _, quorum := range previous.Quorums {
+		keep := true
+		for _, account := range quorum.Signers {
+			isInactive, checked := inactive[account]
+			if !checked {
+				_, jailed, err := k.GetJailedSigner(ctx, account)
+				if err != nil {
+					return types.Quorums{}, 0, err
+				}
+				exitEpoch, exiting, err := k.GetSignerExit(ctx, account)
+				if err != nil {
+					return types.Quorums{}, 0, err
+				}
+				isInactive = jailed || (exiting && exitEpoch <= epoch)
+				inactive[account] = isInactive
+			}
+			if isInactive {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			carried.Quorums = append(carried.Quorums, quorum)
+		}
+	}
+	return carried, uint64(len(previous.Quorums) - len(carried.Quorums)), nil
+}
+
 func (k Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	for k.generateOneEpoch(ctx) {
 	}
+	k.pruneEpochHistory(ctx)
+}
+
+func (k Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
+	if err := k.collectRewards(ctx); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("[EndBlock] failed to collect rewards: %v", err))
+	}
 }
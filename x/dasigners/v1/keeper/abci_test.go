@@ -1,13 +1,16 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/0glabs/0g-chain/x/dasigners/v1"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 )
@@ -16,7 +19,7 @@ type AbciTestSuite struct {
 	testutil.Suite
 }
 
-func (suite *AbciTestSuite) TestBeginBlock_NotContinuous() {
+func (suite *AbciTestSuite) TestBeginBlock_CatchUp() {
 	// suite.App.InitializeFromGenesisStates()
 	// dasigners.InitGenesis(suite.Ctx, suite.Keeper, *types.DefaultGenesisState())
 	params := suite.Keeper.GetParams(suite.Ctx)
@@ -33,9 +36,48 @@ func (suite *AbciTestSuite) TestBeginBlock_NotContinuous() {
 	suite.Require().NoError(err)
 	suite.Assert().EqualValues(epoch, 10)
 
-	suite.Assert().Panics(func() {
+	height, found := suite.Keeper.GetEpochStartHeight(suite.Ctx, epoch)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(height, params.EpochBlocks*10)
+
+	suite.Assert().NotPanics(func() {
 		suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*9)), abci.RequestBeginBlock{})
-	}, "block height is not continuous")
+	})
+	epoch, err = suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(epoch, 10)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_UpdateEpochBlocks() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*3)), abci.RequestBeginBlock{})
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(epoch, 3)
+
+	// the new epoch length applies from the next epoch and is counted from its start height
+	start := params.EpochBlocks * 3
+	newParams := params
+	newParams.EpochBlocks = params.EpochBlocks*2 + 1
+	suite.Keeper.SetPendingParams(suite.Ctx, newParams)
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(start+params.EpochBlocks)), abci.RequestBeginBlock{})
+	epoch, err = suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(epoch, 4)
+
+	start += params.EpochBlocks
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(start+newParams.EpochBlocks-1)), abci.RequestBeginBlock{})
+	epoch, err = suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(epoch, 4)
+
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(start+newParams.EpochBlocks)), abci.RequestBeginBlock{})
+	epoch, err = suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(epoch, 5)
+	height, found := suite.Keeper.GetEpochStartHeight(suite.Ctx, epoch)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(height, start+newParams.EpochBlocks)
 }
 
 func (suite *AbciTestSuite) TestBeginBlock_Success() {
@@ -145,6 +187,239 @@ func (suite *AbciTestSuite) TestBeginBlock_Success() {
 	suite.Assert().EqualValues(cnt, 10)
 }
 
+func (suite *AbciTestSuite) TestBeginBlock_StakeSnapshot() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	// a single signer cannot meet the quorum participation requirements
+	params.MinQuorumSigners = 0
+	params.MaxSignerShareBps = 0
+	suite.Keeper.SetParams(suite.Ctx, params)
+	account := "0000000000000000000000000000000000000001"
+	suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: common.LeftPadBytes([]byte{1}, 32),
+		PubkeyG2: common.LeftPadBytes([]byte{2}, 64),
+	})
+	// more delegations than the former delegation walk limit
+	for i := 0; i < 12; i += 1 {
+		suite.AddDelegation(account, fmt.Sprintf("%040x", i+2), keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	}
+	total := keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)).Mul(sdk.NewInt(12))
+	bonded, err := suite.Keeper.GetSignerStake(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(total, bonded)
+
+	suite.Keeper.SetRegistration(suite.Ctx, 1, account, common.LeftPadBytes([]byte{1}, 32))
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks)), abci.RequestBeginBlock{})
+	response, err := suite.QueryClient.EpochStake(suite.Ctx, &types.QueryEpochStakeRequest{EpochNumber: 1, Account: account})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(total, response.Bonded)
+	quorum, err := suite.Keeper.GetEpochQuorum(suite.Ctx, 1, 0)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(len(quorum.Signers), params.EncodedSlices)
+
+	// later delegations update the tracked stake but not the snapshot
+	suite.AddDelegation(account, fmt.Sprintf("%040x", 2), keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	bonded, err = suite.Keeper.GetSignerStake(suite.Ctx, account)
+	suite.Require().NoError(err)
+	suite.Assert().True(bonded.GT(total))
+	response, err = suite.QueryClient.EpochStake(suite.Ctx, &types.QueryEpochStakeRequest{EpochNumber: 1, Account: account})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(total, response.Bonded)
+
+	_, err = suite.QueryClient.EpochStake(suite.Ctx, &types.QueryEpochStakeRequest{EpochNumber: 2, Account: account})
+	suite.Assert().ErrorIs(err, types.ErrEpochStakeNotFound)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_CarryForward() {
+	suite.Keeper.SetParams(suite.Ctx, types.Params{
+		TokensPerVote:     10,
+		MaxVotesPerSigner: 200,
+		MaxQuorums:        10,
+		EpochBlocks:       5760,
+		EncodedSlices:     10,
+		MinQuorumSigners:  2,
+		MaxSignerShareBps: 7000,
+	})
+	params := suite.Keeper.GetParams(suite.Ctx)
+	accounts := []string{"0000000000000000000000000000000000000001", "0000000000000000000000000000000000000002"}
+	for i, account := range accounts {
+		suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: make([]byte, 64),
+			PubkeyG2: make([]byte, 128),
+		})
+		// 5 ballots each
+		suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote*5)))
+		suite.Keeper.SetRegistration(suite.Ctx, 1, account, common.LeftPadBytes([]byte{byte(i + 1)}, 32))
+	}
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks)), abci.RequestBeginBlock{})
+	formed, err := suite.Keeper.GetEpochQuorums(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(formed.Quorums, 1)
+	suite.Assert().Len(formed.Quorums[0].Signers, int(params.EncodedSlices))
+
+	// the second signer exits while only the first one registers, so a single signer would hold every row
+	suite.Require().NoError(suite.Keeper.SetSignerExit(suite.Ctx, accounts[1], 2))
+	suite.Keeper.SetRegistration(suite.Ctx, 2, accounts[0], common.LeftPadBytes([]byte{1}, 32))
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*2)), abci.RequestBeginBlock{})
+	epoch, err := suite.Keeper.GetEpochNumber(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(2, epoch)
+	// the quorum of the exited signer is not carried forward
+	carried, err := suite.Keeper.GetEpochQuorums(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	suite.Assert().Len(carried.Quorums, 0)
+	found := false
+	for _, e := range suite.Ctx.EventManager().Events() {
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		if err != nil {
+			continue
+		}
+		if carriedForward, ok := event.(*types.EventQuorumsCarriedForward); ok {
+			suite.Assert().EqualValues(2, carriedForward.Epoch)
+			suite.Assert().EqualValues(1, carriedForward.FromEpoch)
+			suite.Assert().NotEmpty(carriedForward.Reason)
+			suite.Assert().EqualValues(1, carriedForward.DroppedQuorums)
+			found = true
+		}
+	}
+	suite.Assert().True(found)
+	// the exited signer no longer serves and is removed
+	_, found, err = suite.Keeper.GetSigner(suite.Ctx, accounts[1])
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+
+	// a third signer restores the participation and the exited signer is removed
+	account := "0000000000000000000000000000000000000003"
+	suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: make([]byte, 64),
+		PubkeyG2: make([]byte, 128),
+	})
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote*5)))
+	suite.Keeper.SetRegistration(suite.Ctx, 3, accounts[0], common.LeftPadBytes([]byte{1}, 32))
+	suite.Keeper.SetRegistration(suite.Ctx, 3, account, common.LeftPadBytes([]byte{3}, 32))
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*3)), abci.RequestBeginBlock{})
+	quorums, err := suite.Keeper.GetEpochQuorums(suite.Ctx, 3)
+	suite.Require().NoError(err)
+	suite.Require().Len(quorums.Quorums, 1)
+	suite.Assert().NotContains(quorums.Quorums[0].Signers, accounts[1])
+
+	// the quorums of active signers are carried forward as they are
+	suite.Keeper.SetRegistration(suite.Ctx, 4, accounts[0], common.LeftPadBytes([]byte{1}, 32))
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*4)), abci.RequestBeginBlock{})
+	carried, err = suite.Keeper.GetEpochQuorums(suite.Ctx, 4)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(quorums, carried)
+
+	// the quorums of a jailed signer are not
+	suite.Require().NoError(suite.Keeper.SetJailedSigner(suite.Ctx, account, 4))
+	suite.Keeper.SetRegistration(suite.Ctx, 5, accounts[0], common.LeftPadBytes([]byte{1}, 32))
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*5)), abci.RequestBeginBlock{})
+	carried, err = suite.Keeper.GetEpochQuorums(suite.Ctx, 5)
+	suite.Require().NoError(err)
+	suite.Assert().Len(carried.Quorums, 0)
+}
+
+func (suite *AbciTestSuite) TestBeginBlock_PruneHistory() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.HistoryRetentionEpochs = 2
+	suite.Keeper.SetParams(suite.Ctx, params)
+	account := "0000000000000000000000000000000000000001"
+	suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  account,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: make([]byte, 64),
+		PubkeyG2: make([]byte, 128),
+	})
+	suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	for epoch := uint64(1); epoch <= 4; epoch += 1 {
+		suite.Keeper.SetRegistration(suite.Ctx, epoch, account, common.LeftPadBytes([]byte{1}, 32))
+		suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks*epoch)), abci.RequestBeginBlock{})
+	}
+	// epochs 0 and 1 fall out of the retention window of epoch 4
+	suite.Assert().EqualValues(2, suite.Keeper.GetEarliestEpoch(suite.Ctx))
+	for epoch := uint64(0); epoch <= 4; epoch += 1 {
+		_, err := suite.Keeper.GetQuorumCount(suite.Ctx, epoch)
+		_, registered, _ := suite.Keeper.GetRegistration(suite.Ctx, epoch, account)
+		_, staked, _ := suite.Keeper.GetEpochStake(suite.Ctx, epoch, account)
+		_, seeded := suite.Keeper.GetEpochSeed(suite.Ctx, epoch)
+		epochParams, snapshotted := suite.Keeper.GetEpochParams(suite.Ctx, epoch)
+		if epoch < 2 {
+			suite.Assert().ErrorIs(err, types.ErrQuorumNotFound)
+			suite.Assert().False(registered)
+			suite.Assert().False(staked)
+			suite.Assert().False(seeded)
+			suite.Assert().False(snapshotted)
+		} else {
+			suite.Assert().NoError(err)
+			suite.Assert().True(registered)
+			suite.Assert().True(staked)
+			suite.Assert().True(seeded)
+			suite.Assert().True(snapshotted)
+			suite.Assert().EqualValues(params, epochParams)
+		}
+	}
+
+	// the pruned history is exported from the earliest epoch
+	gs := dasigners.ExportGenesis(suite.Ctx, suite.Keeper)
+	suite.Require().NoError(gs.Validate())
+	suite.Assert().EqualValues(2, gs.EarliestEpoch)
+	suite.Assert().Len(gs.QuorumsByEpoch, 3)
+	suite.Assert().Len(gs.EpochParams, 3)
+}
+
+func (suite *AbciTestSuite) TestEpochRewards() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	// the configured share of the collected fees is moved to the reward pool
+	err := suite.App.FundModuleAccount(suite.Ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)))
+	suite.Require().NoError(err)
+	suite.Keeper.EndBlock(suite.Ctx, abci.RequestEndBlock{})
+	suite.Assert().EqualValues(sdk.NewIntFromUint64(1000*params.RewardFeeShareBps/types.MaxBasisPoints), suite.Keeper.GetRewardPool(suite.Ctx))
+	pool := suite.Keeper.GetRewardPool(suite.Ctx)
+
+	accounts := []string{"0000000000000000000000000000000000000001", "0000000000000000000000000000000000000002"}
+	for i, account := range accounts {
+		suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: make([]byte, 64),
+			PubkeyG2: make([]byte, 128),
+		})
+		suite.AddDelegation(account, account, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote*uint64(i+1))))
+		suite.Keeper.SetRegistration(suite.Ctx, 1, account, common.LeftPadBytes([]byte{byte(i + 1)}, 32))
+	}
+	suite.Keeper.BeginBlock(suite.Ctx.WithBlockHeight(int64(params.EpochBlocks)), abci.RequestBeginBlock{})
+
+	// the pool is split by ballots, the rounding remainder is kept for the next epoch
+	first, err := suite.Keeper.GetSignerRewards(suite.Ctx, accounts[0])
+	suite.Require().NoError(err)
+	second, err := suite.Keeper.GetSignerRewards(suite.Ctx, accounts[1])
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(pool.QuoRaw(3), first)
+	suite.Assert().EqualValues(pool.MulRaw(2).QuoRaw(3), second)
+	suite.Assert().EqualValues(pool.Sub(first).Sub(second), suite.Keeper.GetRewardPool(suite.Ctx))
+
+	response, err := suite.QueryClient.PendingRewards(suite.Ctx, &types.QueryPendingRewardsRequest{Account: accounts[1]})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(second, response.Amount)
+
+	claimed, err := suite.Keeper.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), &types.MsgClaimRewards{Account: accounts[1]})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(second, claimed.Amount)
+	accAddr, err := sdk.AccAddressFromHexUnsafe(accounts[1])
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(second, suite.App.GetBankKeeper().GetBalance(suite.Ctx, accAddr, bondDenom).Amount)
+	suite.Assert().EqualValues(first, suite.Keeper.GetOutstandingRewards(suite.Ctx))
+	_, err = suite.Keeper.ClaimRewards(sdk.WrapSDKContext(suite.Ctx), &types.MsgClaimRewards{Account: accounts[1]})
+	suite.Assert().ErrorIs(err, types.ErrNoRewards)
+}
+
 func TestAbciSuite(t *testing.T) {
 	suite.Run(t, new(AbciTestSuite))
 }
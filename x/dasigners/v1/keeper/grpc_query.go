@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
-	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -79,39 +81,130 @@ func (k Keeper) EpochQuorumRow(c context.Context, request *types.QueryEpochQuoru
 
 func (k Keeper) AggregatePubkeyG1(c context.Context, request *types.QueryAggregatePubkeyG1Request) (*types.QueryAggregatePubkeyG1Response, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	quorum, err := k.GetEpochQuorum(ctx, request.EpochNumber, request.QuorumId)
+	aggPubkeyG1, _, total, hit, err := k.AggregatePubkeys(ctx, request.EpochNumber, request.QuorumId, request.QuorumBitmap)
 	if err != nil {
 		return nil, err
 	}
-	if (len(quorum.Signers)+7)/8 != len(request.QuorumBitmap) {
-		return nil, types.ErrQuorumBitmapLengthMismatch
+	return &types.QueryAggregatePubkeyG1Response{
+		AggregatePubkeyG1: bn254util.SerializeG1(aggPubkeyG1),
+		Total:             total,
+		Hit:               hit,
+	}, nil
+}
+
+func (k Keeper) EpochStake(c context.Context, request *types.QueryEpochStakeRequest) (*types.QueryEpochStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	bonded, found, err := k.GetEpochStake(ctx, request.EpochNumber, request.Account)
+	if err != nil {
+		return nil, err
 	}
-	aggPubkeyG1 := new(bn254.G1Affine)
-	hit := 0
-	added := make(map[string]struct{})
-	for i, signer := range quorum.Signers {
-		if _, ok := added[signer]; ok {
-			hit += 1
-			continue
-		}
-		b := request.QuorumBitmap[i/8] & (1 << (i % 8))
-		if b == 0 {
-			continue
-		}
-		hit += 1
-		added[signer] = struct{}{}
-		signer, found, err := k.GetSigner(ctx, signer)
-		if err != nil {
-			return nil, err
+	if !found {
+		return nil, types.ErrEpochStakeNotFound
+	}
+	return &types.QueryEpochStakeResponse{Bonded: bonded}, nil
+}
+
+func (k Keeper) EpochSeed(c context.Context, request *types.QueryEpochSeedRequest) (*types.QueryEpochSeedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	seed, found := k.GetEpochSeed(ctx, request.EpochNumber)
+	if !found {
+		return nil, types.ErrEpochSeedNotFound
+	}
+	return &types.QueryEpochSeedResponse{Seed: seed}, nil
+}
+
+func (k Keeper) EpochParams(c context.Context, request *types.QueryEpochParamsRequest) (*types.QueryEpochParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params, found := k.GetEpochParams(ctx, request.EpochNumber)
+	if !found {
+		return nil, types.ErrEpochParamsNotFound
+	}
+	return &types.QueryEpochParamsResponse{Params: params}, nil
+}
+
+func (k Keeper) PendingRewards(c context.Context, request *types.QueryPendingRewardsRequest) (*types.QueryPendingRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	amount, err := k.GetSignerRewards(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingRewardsResponse{Amount: amount}, nil
+}
+
+func (k Keeper) VerifyAggregateSignature(c context.Context, request *types.QueryVerifyAggregateSignatureRequest) (*types.QueryVerifyAggregateSignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	valid, total, hit, err := k.VerifyAggregateSig(ctx, request.EpochNumber, request.QuorumId, request.QuorumBitmap, request.MessageHash, request.AggregateSignature)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVerifyAggregateSignatureResponse{
+		Valid: valid,
+		Total: total,
+		Hit:   hit,
+	}, nil
+}
+
+func (k Keeper) Signers(c context.Context, request *types.QuerySignersRequest) (*types.QuerySignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	signers := make([]*types.Signer, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyPrefix)
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var signer types.Signer
+		if err := k.cdc.Unmarshal(value, &signer); err != nil {
+			return err
 		}
-		if !found {
-			return nil, types.ErrSignerNotFound
+		signers = append(signers, &signer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignersResponse{Signers: signers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EpochRegistrations(c context.Context, request *types.QueryEpochRegistrationsRequest) (*types.QueryEpochRegistrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	registrations := make([]*types.Registration, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(request.EpochNumber))
+	pageRes, err := query.Paginate(store, request.Pagination, func(key []byte, value []byte) error {
+		registrations = append(registrations, &types.Registration{
+			Account:   hex.EncodeToString(key),
+			Signature: value,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEpochRegistrationsResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) EpochQuorums(c context.Context, request *types.QueryEpochQuorumsRequest) (*types.QueryEpochQuorumsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetQuorumCount(ctx, request.EpochNumber); err != nil {
+		return nil, err
+	}
+	quorums := make([]*types.Quorum, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochQuorumsKeyPrefix(request.EpochNumber))
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var quorum types.Quorum
+		if err := k.cdc.Unmarshal(value, &quorum); err != nil {
+			return err
 		}
-		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(signer.PubkeyG1))
+		quorums = append(quorums, &quorum)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return &types.QueryAggregatePubkeyG1Response{
-		AggregatePubkeyG1: bn254util.SerializeG1(aggPubkeyG1),
-		Total:             uint64(len(quorum.Signers)),
-		Hit:               uint64(hit),
-	}, nil
+	return &types.QueryEpochQuorumsResponse{Quorums: quorums, Pagination: pageRes}, nil
+}
+
+func (k Keeper) SignerOperator(c context.Context, request *types.QuerySignerOperatorRequest) (*types.QuerySignerOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	operator, err := k.GetStakeAccount(ctx, request.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySignerOperatorResponse{Operator: operator}, nil
 }
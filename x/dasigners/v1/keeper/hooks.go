@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wrapper struct for dasigners keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks which keep the bonded tokens of the signers up to date
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// refreshSignerStake recomputes the tracked bonded tokens of the signer backed by the delegator
+func (h Hooks) refreshSignerStake(ctx sdk.Context, delAddr sdk.AccAddress, skip sdk.ValAddress) error {
+	account, backed, err := h.k.getBackedSigner(ctx, hex.EncodeToString(delAddr.Bytes()))
+	if err != nil || !backed {
+		return err
+	}
+	_, found, err := h.k.GetSigner(ctx, account)
+	if err != nil || !found {
+		return err
+	}
+	return h.k.SetSignerStake(ctx, account, h.k.getDelegatorBonded(ctx, delAddr, skip))
+}
+
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.refreshSignerStake(ctx, delAddr, nil)
+}
+
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	// the delegation is still in store, skip it
+	return h.refreshSignerStake(ctx, delAddr, valAddr)
+}
+
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) error {
+	// the validator tokens are not deducted yet, drop the tracked stake of the signers delegating to the validator so
+	// that it is recomputed on next read, only the tracked signers are visited, not all delegations of the validator
+	stale := make([]string, 0)
+	var err error
+	h.k.IterateSignerStakes(ctx, func(account string, _ math.Int) (stop bool) {
+		var stakeAccount string
+		stakeAccount, err = h.k.GetStakeAccount(ctx, account)
+		if err != nil {
+			return true
+		}
+		var delAddr sdk.AccAddress
+		delAddr, err = sdk.AccAddressFromHexUnsafe(stakeAccount)
+		if err != nil {
+			return true
+		}
+		if _, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); found {
+			stale = append(stale, account)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, account := range stale {
+		if err := h.k.DeleteSignerStake(ctx, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
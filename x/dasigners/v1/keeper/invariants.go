@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// RegisterInvariants registers the dasigners module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "quorum-count", QuorumCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "quorum-members", QuorumMembersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "epoch-number", EpochNumberInvariant(k))
+}
+
+// AllInvariants runs all invariants of the dasigners module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := QuorumCountInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := QuorumMembersInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return EpochNumberInvariant(k)(ctx)
+	}
+}
+
+// QuorumCountInvariant ensures the quorum count of every retained epoch matches the quorums stored for it
+func QuorumCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		epochNumber, err := k.GetEpochNumber(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "quorum count broken", "epoch number not found"), true
+		}
+		for epoch := k.GetEarliestEpoch(ctx); epoch <= epochNumber; epoch += 1 {
+			quorumCount, err := k.GetQuorumCount(ctx, epoch)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "quorum count broken",
+					fmt.Sprintf("quorum count of epoch %v not found", epoch)), true
+			}
+			stored := uint64(0)
+			iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochQuorumsKeyPrefix(epoch)).Iterator(nil, nil)
+			for ; iterator.Valid(); iterator.Next() {
+				stored += 1
+			}
+			iterator.Close()
+			if stored != quorumCount {
+				return sdk.FormatInvariant(types.ModuleName, "quorum count broken",
+					fmt.Sprintf("epoch %v has quorum count %v but %v quorums stored", epoch, quorumCount, stored)), true
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "quorum count broken", "quorum count matches stored quorums"), false
+	}
+}
+
+// QuorumMembersInvariant ensures every member of the quorums of the current epoch is a registered signer.
+// Signers leaving the set are only removed after the exit cooldown, so they cannot sit in the current quorums.
+func QuorumMembersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		epochNumber, err := k.GetEpochNumber(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "quorum members broken", "epoch number not found"), true
+		}
+		quorumCount, err := k.GetQuorumCount(ctx, epochNumber)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "quorum members broken",
+				fmt.Sprintf("quorum count of epoch %v not found", epochNumber)), true
+		}
+		registered := make(map[string]bool)
+		for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+			quorum, err := k.GetEpochQuorum(ctx, epochNumber, quorumId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "quorum members broken",
+					fmt.Sprintf("quorum %v of epoch %v not found", quorumId, epochNumber)), true
+			}
+			for _, account := range quorum.Signers {
+				found, ok := registered[account]
+				if !ok {
+					_, found, err = k.GetSigner(ctx, account)
+					if err != nil {
+						found = false
+					}
+					registered[account] = found
+				}
+				if !found {
+					return sdk.FormatInvariant(types.ModuleName, "quorum members broken",
+						fmt.Sprintf("quorum %v of epoch %v has unregistered signer %v", quorumId, epochNumber, account)), true
+				}
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "quorum members broken", "all quorum members are registered signers"), false
+	}
+}
+
+// EpochNumberInvariant ensures the epoch number never decreases between checks and never falls behind the earliest retained epoch
+func EpochNumberInvariant(k Keeper) sdk.Invariant {
+	lastEpoch := uint64(0)
+
+	return func(ctx sdk.Context) (string, bool) {
+		epochNumber, err := k.GetEpochNumber(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "epoch number broken", "epoch number not found"), true
+		}
+		if epochNumber < lastEpoch {
+			return sdk.FormatInvariant(types.ModuleName, "epoch number broken",
+				fmt.Sprintf("epoch number decreased from %v to %v", lastEpoch, epochNumber)), true
+		}
+		if earliest := k.GetEarliestEpoch(ctx); epochNumber < earliest {
+			return sdk.FormatInvariant(types.ModuleName, "epoch number broken",
+				fmt.Sprintf("epoch number %v is before the earliest epoch %v", epochNumber, earliest)), true
+		}
+		lastEpoch = epochNumber
+		return sdk.FormatInvariant(types.ModuleName, "epoch number broken", "epoch number never decreased"), false
+	}
+}
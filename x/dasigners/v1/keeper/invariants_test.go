@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/testutil"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+type invariantTestSuite struct {
+	testutil.Suite
+	invariants map[string]map[string]sdk.Invariant
+}
+
+func TestInvariantTestSuite(t *testing.T) {
+	suite.Run(t, new(invariantTestSuite))
+}
+
+func (suite *invariantTestSuite) SetupTest() {
+	suite.Suite.SetupTest()
+
+	suite.invariants = make(map[string]map[string]sdk.Invariant)
+	keeper.RegisterInvariants(suite, suite.Keeper)
+}
+
+// RegisterRoute implements sdk.InvariantRegistry
+func (suite *invariantTestSuite) RegisterRoute(moduleName string, route string, invariant sdk.Invariant) {
+	_, exists := suite.invariants[moduleName]
+
+	if !exists {
+		suite.invariants[moduleName] = make(map[string]sdk.Invariant)
+	}
+
+	suite.invariants[moduleName][route] = invariant
+}
+
+func (suite *invariantTestSuite) runInvariant(route string, invariant func(k keeper.Keeper) sdk.Invariant) (string, bool) {
+	ctx := suite.Ctx
+	registeredInvariant := suite.invariants[types.ModuleName][route]
+	suite.Require().NotNil(registeredInvariant)
+
+	// direct call
+	dMessage, dBroken := invariant(suite.Keeper)(ctx)
+	// registered call
+	rMessage, rBroken := registeredInvariant(ctx)
+	// all call
+	aMessage, aBroken := keeper.AllInvariants(suite.Keeper)(ctx)
+
+	// require matching values for direct call and registered call
+	suite.Require().Equal(dMessage, rMessage, "expected registered invariant message to match")
+	suite.Require().Equal(dBroken, rBroken, "expected registered invariant broken to match")
+	// require matching values for direct call and all invariants call if broken
+	suite.Require().Equal(dBroken, aBroken, "expected all invariant broken to match")
+	if dBroken {
+		suite.Require().Equal(dMessage, aMessage, "expected all invariant message to match")
+	}
+
+	return dMessage, dBroken
+}
+
+func (suite *invariantTestSuite) TestQuorumCountInvariant() {
+	// default state is valid
+	_, broken := suite.runInvariant("quorum-count", keeper.QuorumCountInvariant)
+	suite.Equal(false, broken)
+
+	suite.Keeper.SetEpochQuorums(suite.Ctx, 0, types.Quorums{Quorums: []*types.Quorum{
+		{Signers: []string{}},
+		{Signers: []string{}},
+	}})
+	_, broken = suite.runInvariant("quorum-count", keeper.QuorumCountInvariant)
+	suite.Equal(false, broken)
+
+	// count without the stored quorums
+	suite.Keeper.SetQuorumCount(suite.Ctx, 0, 3)
+	message, broken := suite.runInvariant("quorum-count", keeper.QuorumCountInvariant)
+	suite.Equal("da-signers: quorum count broken invariant\nepoch 0 has quorum count 3 but 2 quorums stored\n", message)
+	suite.Equal(true, broken)
+
+	// missing count of a retained epoch
+	suite.Keeper.SetQuorumCount(suite.Ctx, 0, 2)
+	suite.Keeper.SetEpochNumber(suite.Ctx, 1)
+	message, broken = suite.runInvariant("quorum-count", keeper.QuorumCountInvariant)
+	suite.Equal("da-signers: quorum count broken invariant\nquorum count of epoch 1 not found\n", message)
+	suite.Equal(true, broken)
+}
+
+func (suite *invariantTestSuite) TestQuorumMembersInvariant() {
+	// default state is valid
+	_, broken := suite.runInvariant("quorum-members", keeper.QuorumMembersInvariant)
+	suite.Equal(false, broken)
+
+	suite.Keeper.SetEpochQuorums(suite.Ctx, 0, types.Quorums{Quorums: []*types.Quorum{
+		{Signers: []string{signer1, signer1}},
+	}})
+	message, broken := suite.runInvariant("quorum-members", keeper.QuorumMembersInvariant)
+	suite.Equal("da-signers: quorum members broken invariant\nquorum 0 of epoch 0 has unregistered signer "+signer1+"\n", message)
+	suite.Equal(true, broken)
+
+	suite.Require().NoError(suite.Keeper.SetSigner(suite.Ctx, types.Signer{
+		Account:  signer1,
+		Socket:   "0.0.0.0:1234",
+		PubkeyG1: make([]byte, bn254util.G1PointSize),
+		PubkeyG2: make([]byte, bn254util.G2PointSize),
+	}))
+	_, broken = suite.runInvariant("quorum-members", keeper.QuorumMembersInvariant)
+	suite.Equal(false, broken)
+}
+
+func (suite *invariantTestSuite) TestEpochNumberInvariant() {
+	invariant := suite.invariants[types.ModuleName]["epoch-number"]
+	suite.Require().NotNil(invariant)
+
+	_, broken := invariant(suite.Ctx)
+	suite.Equal(false, broken)
+
+	suite.Keeper.SetEpochNumber(suite.Ctx, 5)
+	_, broken = invariant(suite.Ctx)
+	suite.Equal(false, broken)
+
+	// the epoch number cannot go back once observed
+	suite.Keeper.SetEpochNumber(suite.Ctx, 3)
+	message, broken := invariant(suite.Ctx)
+	suite.Equal("da-signers: epoch number broken invariant\nepoch number decreased from 5 to 3\n", message)
+	suite.Equal(true, broken)
+
+	// a fresh invariant has not observed any epoch, but the epoch cannot fall behind the pruned history
+	suite.Keeper.SetEarliestEpoch(suite.Ctx, 4)
+	message, broken = keeper.EpochNumberInvariant(suite.Keeper)(suite.Ctx)
+	suite.Equal("da-signers: epoch number broken invariant\nepoch number 3 is before the earliest epoch 4\n", message)
+	suite.Equal(true, broken)
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -13,6 +14,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/0glabs/0g-chain/chaincfg"
+	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 )
 
@@ -22,6 +24,10 @@ type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
 	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
+	hooks         types.DASignersHooks
+	// the address capable of executing a MsgUpdateParams message, typically the x/gov module account
+	authority string
 }
 
 // NewKeeper creates a new das Keeper instance
@@ -29,14 +35,32 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	stakingKeeper types.StakingKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
 		stakingKeeper: stakingKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
+// SetHooks sets the dasigners hooks, it must be called before the keeper is copied into other modules
+func (k *Keeper) SetHooks(dh types.DASignersHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set dasigners hooks twice")
+	}
+	k.hooks = dh
+	return k
+}
+
+// GetAuthority returns the x/dasigners module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -56,6 +80,40 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store.Set(types.ParamsKey, bz)
 }
 
+func (k Keeper) GetPendingParams(ctx sdk.Context) (types.Params, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingParamsKey)
+	if bz == nil {
+		return types.Params{}, false
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, true
+}
+
+// SetPendingParams schedules params to be applied at the next epoch boundary
+func (k Keeper) SetPendingParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.PendingParamsKey, bz)
+}
+
+// applyPendingParams replaces the params with the scheduled ones if there are any
+func (k Keeper) applyPendingParams(ctx sdk.Context) {
+	params, found := k.GetPendingParams(ctx)
+	if !found {
+		return
+	}
+	k.SetParams(ctx, params)
+	ctx.KVStore(k.storeKey).Delete(types.PendingParamsKey)
+}
+
+// ConsumePairingGas charges the gas of one signature check in the gas schedule. The gas schedule meters the precompile
+// only, the msgs of the module pay their signature checks with the tx fees as the other sdk msgs do.
+func (k Keeper) ConsumePairingGas(ctx sdk.Context) {
+	ctx.GasMeter().ConsumeGas(k.GetParams(ctx).GasSchedule.PairingCost, "dasigners pairing")
+}
+
 func (k Keeper) GetEpochNumber(ctx sdk.Context) (uint64, error) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.EpochNumberKey)
@@ -70,6 +128,87 @@ func (k Keeper) SetEpochNumber(ctx sdk.Context, epoch uint64) {
 	store.Set(types.EpochNumberKey, sdk.Uint64ToBigEndian(epoch))
 }
 
+func (k Keeper) GetEpochStartHeight(ctx sdk.Context, epoch uint64) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochStartHeightKeyPrefix)
+	bz := store.Get(types.GetEpochStartHeightKey(epoch))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+func (k Keeper) SetEpochStartHeight(ctx sdk.Context, epoch uint64, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochStartHeightKeyPrefix)
+	store.Set(types.GetEpochStartHeightKey(epoch), sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) GetEpochSeed(ctx sdk.Context, epoch uint64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochSeedKeyPrefix)
+	bz := store.Get(types.GetEpochSeedKey(epoch))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+func (k Keeper) SetEpochSeed(ctx sdk.Context, epoch uint64, seed []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochSeedKeyPrefix)
+	store.Set(types.GetEpochSeedKey(epoch), seed)
+}
+
+func (k Keeper) IterateEpochSeeds(ctx sdk.Context, fn func(epoch uint64, seed []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochSeedKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(sdk.BigEndianToUint64(iterator.Key()), iterator.Value()) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetEpochParams(ctx sdk.Context, epoch uint64) (types.Params, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochParamsKeyPrefix)
+	bz := store.Get(types.GetEpochParamsKey(epoch))
+	if bz == nil {
+		return types.Params{}, false
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params, true
+}
+
+// SetEpochParams snapshots the params the quorums of the epoch are generated with, so the epoch can be
+// verified after the params change
+func (k Keeper) SetEpochParams(ctx sdk.Context, epoch uint64, params types.Params) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochParamsKeyPrefix)
+	store.Set(types.GetEpochParamsKey(epoch), k.cdc.MustMarshal(&params))
+}
+
+func (k Keeper) IterateEpochParams(ctx sdk.Context, fn func(epoch uint64, params types.Params) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochParamsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var params types.Params
+		k.cdc.MustUnmarshal(iterator.Value(), &params)
+		if fn(sdk.BigEndianToUint64(iterator.Key()), params) {
+			break
+		}
+	}
+}
+
+// getEpochAnchor returns the start height of the given epoch, epochs stored before the anchors
+// were introduced are assumed to follow the fixed height / epoch_blocks schedule
+func (k Keeper) getEpochAnchor(ctx sdk.Context, epoch uint64, params types.Params) uint64 {
+	if height, found := k.GetEpochStartHeight(ctx, epoch); found {
+		return height
+	}
+	return epoch * params.EpochBlocks
+}
+
 func (k Keeper) GetQuorumCount(ctx sdk.Context, epoch uint64) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QuorumCountKeyPrefix)
 	bz := store.Get(types.GetQuorumCountKey(epoch))
@@ -142,6 +281,135 @@ func (k Keeper) IterateSigners(ctx sdk.Context, fn func(index int64, signer type
 	}
 }
 
+func (k Keeper) DeleteSigner(ctx sdk.Context, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyPrefix)
+	key, err := types.GetSignerKeyFromAccount(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	if err := k.DeleteSignerStake(ctx, account); err != nil {
+		return err
+	}
+	// the operator of a removed signer can back another one
+	if err := k.DeleteSignerOperator(ctx, account); err != nil {
+		return err
+	}
+	if err := k.DeleteSignerKeyRotation(ctx, account); err != nil {
+		return err
+	}
+	if err := k.deletePreviousSignerKeys(ctx, account); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, account),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) GetSignerExit(ctx sdk.Context, account string) (uint64, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	key, err := types.GetSignerExitKey(account)
+	if err != nil {
+		return 0, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return 0, false, nil
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
+func (k Keeper) SetSignerExit(ctx sdk.Context, account string, exitEpoch uint64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	key, err := types.GetSignerExitKey(account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(exitEpoch))
+	return nil
+}
+
+func (k Keeper) DeleteSignerExit(ctx sdk.Context, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerExitKeyPrefix)
+	key, err := types.GetSignerExitKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// iterate through the exiting signers and perform the provided function
+func (k Keeper) IterateSignerExits(ctx sdk.Context, fn func(account string, exitEpoch uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.SignerExitKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stop := fn(hex.EncodeToString((iterator.Key())[len(prefix):]), sdk.BigEndianToUint64(iterator.Value()))
+
+		if stop {
+			break
+		}
+	}
+}
+
+// removeExitedSigners deletes the signers whose exit cooldown has ended by the given epoch. Signers still sitting
+// in the quorums of the epoch, which happens when quorums are carried forward, are kept until they leave them.
+func (k Keeper) removeExitedSigners(ctx sdk.Context, epoch uint64, quorums types.Quorums) error {
+	serving := make(map[string]bool)
+	for _, quorum := range quorums.Quorums {
+		for _, account := range quorum.Signers {
+			serving[account] = true
+		}
+	}
+	exited := make([]string, 0)
+	k.IterateSignerExits(ctx, func(account string, exitEpoch uint64) (stop bool) {
+		if exitEpoch <= epoch && !serving[account] {
+			exited = append(exited, account)
+		}
+		return false
+	})
+	for _, account := range exited {
+		if err := k.DeleteSigner(ctx, account); err != nil {
+			return err
+		}
+		if err := k.DeleteSignerExit(ctx, account); err != nil {
+			return err
+		}
+		if k.hooks != nil {
+			if err := k.hooks.AfterSignerRemoved(ctx, account); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetEpochQuorums returns all quorums of the epoch
+func (k Keeper) GetEpochQuorums(ctx sdk.Context, epoch uint64) (types.Quorums, error) {
+	quorumCount, err := k.GetQuorumCount(ctx, epoch)
+	if err != nil {
+		return types.Quorums{}, err
+	}
+	quorums := types.Quorums{Quorums: make([]*types.Quorum, quorumCount)}
+	for quorumId := uint64(0); quorumId < quorumCount; quorumId += 1 {
+		quorum, err := k.GetEpochQuorum(ctx, epoch, quorumId)
+		if err != nil {
+			return types.Quorums{}, err
+		}
+		quorums.Quorums[quorumId] = &quorum
+	}
+	return quorums, nil
+}
+
 func (k Keeper) GetEpochQuorum(ctx sdk.Context, epoch uint64, quorumId uint64) (types.Quorum, error) {
 	quorumCount, err := k.GetQuorumCount(ctx, epoch)
 	if err != nil {
@@ -212,33 +480,167 @@ func (k Keeper) SetRegistration(ctx sdk.Context, epoch uint64, account string, s
 	return nil
 }
 
+func (k Keeper) DeleteRegistration(ctx sdk.Context, epoch uint64, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochRegistrationKeyPrefix(epoch))
+	key, err := types.GetRegistrationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetDelegatorBonded walks all delegations of the delegator and sums up the delegated tokens
 func (k Keeper) GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int {
+	return k.getDelegatorBonded(ctx, delegator, nil)
+}
+
+// getDelegatorBonded sums up the delegated tokens of the delegator, the delegation to the skipped validator is ignored
+func (k Keeper) getDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress, skip sdk.ValAddress) math.Int {
 	bonded := sdk.ZeroDec()
 
-	cnt := 0
 	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
 		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
 		if err != nil {
 			panic(err) // shouldn't happen
 		}
+		if validatorAddr.Equals(skip) {
+			return false
+		}
 		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
 		if found {
 			shares := delegation.Shares
 			tokens := validator.TokensFromSharesTruncated(shares)
 			bonded = bonded.Add(tokens)
 		}
-		cnt += 1
-		return cnt > 10
+		return false
 	})
 	return bonded.RoundInt()
 }
 
+// GetSignerStake returns the bonded tokens backing the signer tracked by the staking hooks,
+// it falls back to walking the delegations of the signer, or of its operator, if the signer is not tracked yet
+func (k Keeper) GetSignerStake(ctx sdk.Context, account string) (math.Int, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerStakeKeyPrefix)
+	key, err := types.GetSignerStakeKey(account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	bz := store.Get(key)
+	if bz != nil {
+		var bonded math.Int
+		if err := bonded.Unmarshal(bz); err != nil {
+			return math.Int{}, err
+		}
+		return bonded, nil
+	}
+	stakeAccount, err := k.GetStakeAccount(ctx, account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(stakeAccount)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return k.GetDelegatorBonded(ctx, accAddr), nil
+}
+
+func (k Keeper) SetSignerStake(ctx sdk.Context, account string, bonded math.Int) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerStakeKeyPrefix)
+	key, err := types.GetSignerStakeKey(account)
+	if err != nil {
+		return err
+	}
+	bz, err := bonded.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) DeleteSignerStake(ctx sdk.Context, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerStakeKeyPrefix)
+	key, err := types.GetSignerStakeKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// IterateSignerStakes iterates the bonded tokens tracked for the signers
+func (k Keeper) IterateSignerStakes(ctx sdk.Context, fn func(account string, bonded math.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerStakeKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bonded math.Int
+		if err := bonded.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if fn(hex.EncodeToString(iterator.Key()), bonded) {
+			break
+		}
+	}
+}
+
+// GetEpochStake returns the bonded tokens snapshot of the signer taken when the registration of the epoch closed
+func (k Keeper) GetEpochStake(ctx sdk.Context, epoch uint64, account string) (math.Int, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochStakeKeyPrefix(epoch))
+	key, err := types.GetEpochStakeKey(account)
+	if err != nil {
+		return math.Int{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return math.Int{}, false, nil
+	}
+	var bonded math.Int
+	if err := bonded.Unmarshal(bz); err != nil {
+		return math.Int{}, false, err
+	}
+	return bonded, true, nil
+}
+
+func (k Keeper) SetEpochStake(ctx sdk.Context, epoch uint64, account string, bonded math.Int) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetEpochStakeKeyPrefix(epoch))
+	key, err := types.GetEpochStakeKey(account)
+	if err != nil {
+		return err
+	}
+	bz, err := bonded.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IterateEpochStakes iterates the bonded tokens snapshots of the signers by epoch
+func (k Keeper) IterateEpochStakes(ctx sdk.Context, fn func(epoch uint64, account string, bonded math.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochStakeKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bonded math.Int
+		if err := bonded.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		key := iterator.Key()
+		if fn(sdk.BigEndianToUint64(key[:8]), hex.EncodeToString(key[8:]), bonded) {
+			break
+		}
+	}
+}
+
 func (k Keeper) CheckDelegations(ctx sdk.Context, account string) error {
-	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	bonded, err := k.GetSignerStake(ctx, account)
 	if err != nil {
 		return err
 	}
-	bonded := k.GetDelegatorBonded(ctx, accAddr)
 	params := k.GetParams(ctx)
 	tokensPerVote := sdk.NewIntFromUint64(params.TokensPerVote)
 	if bonded.Quo(BondedConversionRate).Quo(tokensPerVote).Abs().BigInt().Cmp(big.NewInt(0)) <= 0 {
@@ -246,3 +648,74 @@ func (k Keeper) CheckDelegations(ctx sdk.Context, account string) error {
 	}
 	return nil
 }
+
+// AggregatePubkeys aggregates the pubkeys of the signers set in the quorum bitmap, each signer is counted once,
+// hit is the number of quorum rows held by the aggregated signers
+func (k Keeper) AggregatePubkeys(ctx sdk.Context, epoch uint64, quorumId uint64, quorumBitmap []byte) (*bn254.G1Affine, *bn254.G2Affine, uint64, uint64, error) {
+	quorum, err := k.GetEpochQuorum(ctx, epoch, quorumId)
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	if (len(quorum.Signers)+7)/8 != len(quorumBitmap) {
+		return nil, nil, 0, 0, types.ErrQuorumBitmapLengthMismatch
+	}
+	aggregateCost := k.GetParams(ctx).GasSchedule.AggregateCostPerSigner
+	aggPubkeyG1 := new(bn254.G1Affine)
+	aggPubkeyG2 := new(bn254.G2Affine)
+	hit := 0
+	added := make(map[string]struct{})
+	for i, signer := range quorum.Signers {
+		if _, ok := added[signer]; ok {
+			hit += 1
+			continue
+		}
+		b := quorumBitmap[i/8] & (1 << (i % 8))
+		if b == 0 {
+			continue
+		}
+		hit += 1
+		added[signer] = struct{}{}
+		// resolve the key the signer used in the epoch, it may have been rotated since
+		pubkeyG1, pubkeyG2, found, err := k.GetSignerKeyAtEpoch(ctx, signer, epoch)
+		if err != nil {
+			return nil, nil, 0, 0, err
+		}
+		if !found {
+			return nil, nil, 0, 0, types.ErrSignerNotFound
+		}
+		ctx.GasMeter().ConsumeGas(aggregateCost, "dasigners aggregate pubkey")
+		aggPubkeyG1.Add(aggPubkeyG1, bn254util.DeserializeG1(pubkeyG1))
+		aggPubkeyG2.Add(aggPubkeyG2, bn254util.DeserializeG2(pubkeyG2))
+	}
+	return aggPubkeyG1, aggPubkeyG2, uint64(len(quorum.Signers)), uint64(hit), nil
+}
+
+// VerifyAggregateSig verifies the aggregated G1 signature of the signers set in the quorum bitmap on the message hash,
+// it returns the validity together with the signed fraction hit / total of the quorum rows
+func (k Keeper) VerifyAggregateSig(ctx sdk.Context, epoch uint64, quorumId uint64, quorumBitmap []byte, msgHash []byte, aggSig []byte) (bool, uint64, uint64, error) {
+	if len(msgHash) != 32 {
+		return false, 0, 0, types.ErrInvalidMessageHash
+	}
+	if len(aggSig) != bn254util.G1PointSize {
+		return false, 0, 0, types.ErrInvalidSignature
+	}
+	_, aggPubkeyG2, total, hit, err := k.AggregatePubkeys(ctx, epoch, quorumId, quorumBitmap)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	if hit == 0 {
+		return false, total, hit, nil
+	}
+	sig := bn254util.DeserializeG1(aggSig)
+	if !sig.IsOnCurve() {
+		return false, total, hit, nil
+	}
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+	k.ConsumePairingGas(ctx)
+	valid, err := bn254util.VerifySig(sig, aggPubkeyG2, msgHash32)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	return valid, total, hit, nil
+}
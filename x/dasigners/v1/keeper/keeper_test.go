@@ -13,7 +13,13 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -22,6 +28,8 @@ const (
 	signer2 = "9685C4EB29309820CDC62663CC6CC82F3D42E965"
 )
 
+var epochBlockHash = common.FromHex("592e3dbc95ab2cac4114a6c89e1435c2f534266fbc46b9133df094e5e15ad3c8")
+
 type KeeperTestSuite struct {
 	testutil.Suite
 }
@@ -172,17 +180,23 @@ func (suite *KeeperTestSuite) testRegisterEpochSuccess() {
 		Account:   signer1,
 		Signature: bn254util.SerializeG1(signature),
 	}
-	_, err := suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), msg)
+	response, err := suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), msg)
 	suite.Assert().NoError(err, types.ErrSignerNotFound)
-
+	suite.Assert().EqualValues(1, response.Epoch)
+	events := suite.Ctx.EventManager().Events()
+	event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(&types.EventEpochRegistration{Account: signer1, Epoch: 1}, event)
 }
 
 func (suite *KeeperTestSuite) newEpoch(params types.Params) {
-	// 1st ballot of signer1: 1d5df5684184f84a8dbd20b158b6478a6e8eb021b1cf81ac281dd4c7af4370ed30231e1a6a1d76bac5f464f10c7e99afa8df3c4643ca447bfc80f248764ab2ac
-	// 1st ballot of signer2: 103d29532b47eb7df57049180475d72737f7ab2be4a0f3614aedbb61c8a844a32c76fcbb29b937c56c577121dfd4be8041e2b4acfe2523ae54f0d6f604745b06
-	// 2nd ballot of signer2: 93a5bb4c22640a155b18e24c0c584f2bc4bdd94ddb786d86ff3c3816d741e67f
+	// block hash: 592e3dbc95ab2cac4114a6c89e1435c2f534266fbc46b9133df094e5e15ad3c8
+	// seed of epoch 1: 9369ad11187fad1acb054a7068299d5e0f2b333cceaed81256907748d4c6dce7
+	// 1st ballot of signer1: aee0e787bc42b5d6a91f759db30547fe59b17c3d54a99368b3c31d40633fac56
+	// 1st ballot of signer2: 896a5622f57c557e222026331c4f79ca6ceaa1d07ef2fa77749eb383f4cfc80f
+	// 2nd ballot of signer2: d781ff10b46b42d6f578d8ae220fa09902215fffc74e76f07f504f653a6c9f2d
 	// sorted ballots: 2-1, 1-1, 2-2
-	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 1)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 1).WithHeaderHash(epochBlockHash)
 	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
 }
 
@@ -192,6 +206,51 @@ func (suite *KeeperTestSuite) queryEpochNumber() {
 	suite.Assert().EqualValues(response.EpochNumber, 1)
 }
 
+func (suite *KeeperTestSuite) queryEpochSeed() {
+	_, err := suite.Keeper.EpochSeed(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochSeedRequest{
+		EpochNumber: 2,
+	})
+	suite.Assert().ErrorIs(err, types.ErrEpochSeedNotFound)
+	response, err := suite.Keeper.EpochSeed(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochSeedRequest{
+		EpochNumber: 1,
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(response.Seed, crypto.Keccak256(epochBlockHash, sdk.Uint64ToBigEndian(1)))
+}
+
+func (suite *KeeperTestSuite) queryEpochParams(params types.Params) {
+	_, err := suite.Keeper.EpochParams(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochParamsRequest{
+		EpochNumber: 2,
+	})
+	suite.Assert().ErrorIs(err, types.ErrEpochParamsNotFound)
+	// the snapshot of a generated epoch does not follow later params changes
+	newParams := params
+	newParams.EncodedSlices = params.EncodedSlices * 2
+	newParams.MaxQuorums = params.MaxQuorums + 1
+	suite.Keeper.SetParams(suite.Ctx, newParams)
+	response, err := suite.Keeper.EpochParams(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochParamsRequest{
+		EpochNumber: 1,
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(params, response.Params)
+	suite.Keeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) queryNewEpochEvent() {
+	seed, found := suite.Keeper.GetEpochSeed(suite.Ctx, 1)
+	suite.Require().True(found)
+	for _, e := range suite.Ctx.EventManager().Events() {
+		if e.Type != proto.MessageName(&types.EventNewEpoch{}) {
+			continue
+		}
+		event, err := sdk.ParseTypedEvent(abci.Event(e))
+		suite.Require().NoError(err)
+		suite.Assert().EqualValues(&types.EventNewEpoch{Epoch: 1, QuorumCount: 1, Seed: seed}, event)
+		return
+	}
+	suite.Fail("new epoch event not emitted")
+}
+
 func (suite *KeeperTestSuite) queryQuorumCount() {
 	response, err := suite.Keeper.QuorumCount(sdk.WrapSDKContext(suite.Ctx), &types.QueryQuorumCountRequest{
 		EpochNumber: 1,
@@ -309,6 +368,116 @@ func (suite *KeeperTestSuite) queryAggregatePubkeyG1(params types.Params) {
 	suite.Assert().EqualValues(response.Hit, params.EncodedSlices)
 }
 
+func (suite *KeeperTestSuite) queryVerifyAggregateSignature(params types.Params) {
+	msgHash := crypto.Keccak256([]byte("da blob"))
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+	hash := bn254util.MapToCurve(msgHash32)
+	quorumBitMap := make([]byte, params.EncodedSlices/8)
+
+	// signed by both signers
+	quorumBitMap[0] = byte(3)
+	signature := new(bn254.G1Affine).ScalarMultiplication(hash, big.NewInt(1+11))
+	response, err := suite.Keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.Ctx), &types.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        1,
+		QuorumId:           0,
+		QuorumBitmap:       quorumBitMap,
+		MessageHash:        msgHash,
+		AggregateSignature: bn254util.SerializeG1(signature),
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().True(response.Valid)
+	suite.Assert().EqualValues(response.Total, params.EncodedSlices)
+	suite.Assert().EqualValues(response.Hit, params.EncodedSlices)
+
+	// bitmap does not match the signature
+	quorumBitMap[0] = byte(2)
+	response, err = suite.Keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.Ctx), &types.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        1,
+		QuorumId:           0,
+		QuorumBitmap:       quorumBitMap,
+		MessageHash:        msgHash,
+		AggregateSignature: bn254util.SerializeG1(signature),
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().False(response.Valid)
+
+	// signed by signer1 only
+	signature = new(bn254.G1Affine).ScalarMultiplication(hash, big.NewInt(1))
+	response, err = suite.Keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.Ctx), &types.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        1,
+		QuorumId:           0,
+		QuorumBitmap:       quorumBitMap,
+		MessageHash:        msgHash,
+		AggregateSignature: bn254util.SerializeG1(signature),
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().True(response.Valid)
+	suite.Assert().EqualValues(response.Total, params.EncodedSlices)
+	suite.Assert().EqualValues(response.Hit, params.EncodedSlices/3)
+
+	// no signer
+	quorumBitMap[0] = byte(0)
+	response, err = suite.Keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.Ctx), &types.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        1,
+		QuorumId:           0,
+		QuorumBitmap:       quorumBitMap,
+		MessageHash:        msgHash,
+		AggregateSignature: bn254util.SerializeG1(new(bn254.G1Affine)),
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().False(response.Valid)
+
+	_, err = suite.Keeper.VerifyAggregateSignature(sdk.WrapSDKContext(suite.Ctx), &types.QueryVerifyAggregateSignatureRequest{
+		EpochNumber:        1,
+		QuorumId:           0,
+		QuorumBitmap:       quorumBitMap,
+		MessageHash:        msgHash[:31],
+		AggregateSignature: bn254util.SerializeG1(signature),
+	})
+	suite.Assert().ErrorIs(err, types.ErrInvalidMessageHash)
+}
+
+func (suite *KeeperTestSuite) queryPaginated() {
+	signers, err := suite.Keeper.Signers(sdk.WrapSDKContext(suite.Ctx), &types.QuerySignersRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(len(signers.Signers), 1)
+	suite.Assert().EqualValues(signers.Pagination.Total, 2)
+	nextSigners, err := suite.Keeper.Signers(sdk.WrapSDKContext(suite.Ctx), &types.QuerySignersRequest{
+		Pagination: &query.PageRequest{Key: signers.Pagination.NextKey},
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(len(nextSigners.Signers), 1)
+	suite.Assert().NotEqualValues(signers.Signers[0].Account, nextSigners.Signers[0].Account)
+
+	registrations, err := suite.Keeper.EpochRegistrations(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochRegistrationsRequest{
+		EpochNumber: 1,
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(len(registrations.Registrations), 2)
+	for _, registration := range registrations.Registrations {
+		signature, found, err := suite.Keeper.GetRegistration(suite.Ctx, 1, registration.Account)
+		suite.Assert().NoError(err)
+		suite.Assert().True(found)
+		suite.Assert().EqualValues(signature, registration.Signature)
+	}
+
+	quorums, err := suite.Keeper.EpochQuorums(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochQuorumsRequest{
+		EpochNumber: 1,
+	})
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(len(quorums.Quorums), 1)
+	quorum, err := suite.Keeper.GetEpochQuorum(suite.Ctx, 1, 0)
+	suite.Assert().NoError(err)
+	suite.Assert().EqualValues(*quorums.Quorums[0], quorum)
+	_, err = suite.Keeper.EpochQuorums(sdk.WrapSDKContext(suite.Ctx), &types.QueryEpochQuorumsRequest{
+		EpochNumber: 2,
+	})
+	suite.Assert().ErrorIs(err, types.ErrQuorumNotFound)
+}
+
 func (suite *KeeperTestSuite) Test_Keeper() {
 	// suite.App.InitializeFromGenesisStates()
 	// dasigners.InitGenesis(suite.Ctx, suite.Keeper, *types.DefaultGenesisState())
@@ -326,10 +495,497 @@ func (suite *KeeperTestSuite) Test_Keeper() {
 	suite.secondSigner()
 	suite.newEpoch(params)
 	suite.queryEpochNumber()
+	suite.queryEpochSeed()
+	suite.queryNewEpochEvent()
 	suite.queryQuorumCount()
 	suite.queryEpochQuorum(params)
 	suite.queryEpochQuorumRow(params)
 	suite.queryAggregatePubkeyG1(params)
+	suite.queryVerifyAggregateSignature(params)
+	suite.queryPaginated()
+	suite.queryEpochParams(params)
+}
+
+func (suite *KeeperTestSuite) Test_DeregisterSigner() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	suite.AddDelegation(signer1, signer1, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	signer := suite.testRegisterSignerSuccess()
+	suite.testRegisterEpochSuccess()
+	_, found, err := suite.Keeper.GetRegistration(suite.Ctx, 1, signer.Account)
+	suite.Require().NoError(err)
+	suite.Assert().True(found)
+
+	_, err = suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgDeregisterSigner{Account: signer2})
+	suite.Assert().ErrorIs(err, types.ErrSignerNotFound)
+	_, err = suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgDeregisterSigner{Account: signer.Account})
+	suite.Require().NoError(err)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
+		types.EventTypeDeregisterSigner,
+		sdk.NewAttribute(types.AttributeKeySigner, signer.Account),
+		sdk.NewAttribute(types.AttributeKeyExitEpoch, "3"),
+	))
+	_, err = suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgDeregisterSigner{Account: signer.Account})
+	suite.Assert().ErrorIs(err, types.ErrSignerExiting)
+
+	// registration for the next epoch is dropped and cannot be renewed
+	_, found, err = suite.Keeper.GetRegistration(suite.Ctx, 1, signer.Account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	sk := big.NewInt(1)
+	hash := types.EpochRegistrationHash(common.HexToAddress(signer1), 1, big.NewInt(8888))
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   signer1,
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+	})
+	suite.Assert().ErrorIs(err, types.ErrSignerExiting)
+
+	// signer is kept during the cooldown
+	for epoch := uint64(1); epoch < 3; epoch += 1 {
+		suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks * epoch))
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+		_, found, err = suite.Keeper.GetSigner(suite.Ctx, signer.Account)
+		suite.Require().NoError(err)
+		suite.Assert().True(found)
+	}
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks * 3))
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	_, found, err = suite.Keeper.GetSigner(suite.Ctx, signer.Account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	_, found, err = suite.Keeper.GetSignerExit(suite.Ctx, signer.Account)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+}
+
+type recordingHooks struct {
+	registered []string
+	epochs     map[uint64]int
+	removed    []string
+}
+
+var _ types.DASignersHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterSignerRegistered(_ sdk.Context, signer types.Signer) error {
+	h.registered = append(h.registered, signer.Account)
+	return nil
+}
+
+func (h *recordingHooks) AfterEpochGenerated(_ sdk.Context, epoch uint64, quorums types.Quorums) error {
+	h.epochs[epoch] = len(quorums.Quorums)
+	return nil
+}
+
+func (h *recordingHooks) AfterSignerRemoved(_ sdk.Context, account string) error {
+	h.removed = append(h.removed, account)
+	return nil
+}
+
+func (suite *KeeperTestSuite) Test_DASignersHooks() {
+	hooks := &recordingHooks{epochs: make(map[uint64]int)}
+	k := keeper.NewKeeper(suite.App.GetKVStoreKey(types.StoreKey), suite.App.AppCodec(), suite.StakingKeeper, suite.App.GetBankKeeper(), suite.Keeper.GetAuthority())
+	k.SetHooks(types.NewMultiDASignersHooks(hooks))
+	suite.Assert().Panics(func() { k.SetHooks(hooks) })
+	suite.Keeper = k
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	// a single signer cannot meet the quorum participation requirements
+	params.MinQuorumSigners = 0
+	params.MaxSignerShareBps = 0
+	suite.Keeper.SetParams(suite.Ctx, params)
+	suite.AddDelegation(signer1, signer1, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	suite.testRegisterSignerSuccess()
+	suite.Assert().EqualValues([]string{signer1}, hooks.registered)
+	suite.testRegisterEpochSuccess()
+	suite.newEpoch(params)
+	suite.Assert().EqualValues(map[uint64]int{1: 1}, hooks.epochs)
+
+	_, err := suite.Keeper.DeregisterSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgDeregisterSigner{Account: signer1})
+	suite.Require().NoError(err)
+	// the signer is removed once its exit cooldown has ended
+	for epoch := uint64(2); epoch <= 4; epoch += 1 {
+		suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks * epoch))
+		suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	}
+	suite.Assert().EqualValues(map[uint64]int{1: 1, 2: 0, 3: 0, 4: 0}, hooks.epochs)
+	suite.Assert().EqualValues([]string{strings.ToLower(signer1)}, hooks.removed)
+}
+
+func (suite *KeeperTestSuite) rotateSignerKey(account string, sk *big.Int, nonce uint64) (*types.MsgRotateSignerKeyResponse, error) {
+	pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)
+	pkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+	hash := types.PubkeyRotationHash(common.HexToAddress(account), big.NewInt(8888), nonce)
+	signature := new(bn254.G1Affine).ScalarMultiplication(hash, sk)
+	return suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgRotateSignerKey{
+		Account:   account,
+		PubkeyG1:  bn254util.SerializeG1(pkG1),
+		PubkeyG2:  bn254util.SerializeG2(pkG2),
+		Signature: bn254util.SerializeG1(signature),
+	})
+}
+
+func (suite *KeeperTestSuite) aggregatePubkeyG1(epoch uint64) []byte {
+	quorum, err := suite.Keeper.GetEpochQuorum(suite.Ctx, epoch, 0)
+	suite.Require().NoError(err)
+	bitmap := make([]byte, (len(quorum.Signers)+7)/8)
+	for i := range bitmap {
+		bitmap[i] = 0xff
+	}
+	response, err := suite.Keeper.AggregatePubkeyG1(sdk.WrapSDKContext(suite.Ctx), &types.QueryAggregatePubkeyG1Request{
+		EpochNumber:  epoch,
+		QuorumId:     0,
+		QuorumBitmap: bitmap,
+	})
+	suite.Require().NoError(err)
+	return response.AggregatePubkeyG1
+}
+
+func (suite *KeeperTestSuite) Test_RotateSignerKey() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	// a single signer cannot meet the quorum participation requirements
+	params.MinQuorumSigners = 0
+	params.MaxSignerShareBps = 0
+	suite.Keeper.SetParams(suite.Ctx, params)
+	suite.AddDelegation(signer1, signer1, keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote)))
+	_, err := suite.rotateSignerKey(signer1, big.NewInt(2), 1)
+	suite.Assert().ErrorIs(err, types.ErrSignerNotFound)
+	signer := suite.testRegisterSignerSuccess()
+	suite.testRegisterEpochSuccess()
+	suite.newEpoch(params)
+
+	// invalid proof of possession
+	_, err = suite.rotateSignerKey(signer1, big.NewInt(2), 2)
+	suite.Assert().ErrorIs(err, types.ErrInvalidSignature)
+	_, err = suite.rotateSignerKey(signer1, big.NewInt(1), 1)
+	suite.Assert().ErrorIs(err, types.ErrSignerKeyUnchanged)
+	// the G2 key is not kept either
+	_, err = suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgRotateSignerKey{
+		Account:   signer1,
+		PubkeyG1:  bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), big.NewInt(2))),
+		PubkeyG2:  signer.PubkeyG2,
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(types.PubkeyRotationHash(common.HexToAddress(signer1), big.NewInt(8888), 1), big.NewInt(2))),
+	})
+	suite.Assert().ErrorIs(err, types.ErrSignerKeyUnchanged)
+	// a registration proof of possession is not a rotation one
+	_, err = suite.Keeper.RotateSignerKey(sdk.WrapSDKContext(suite.Ctx), &types.MsgRotateSignerKey{
+		Account:   signer1,
+		PubkeyG1:  bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), big.NewInt(2))),
+		PubkeyG2:  bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), big.NewInt(2))),
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(types.PubkeyRegistrationHash(common.HexToAddress(signer1), big.NewInt(8888)), big.NewInt(2))),
+	})
+	suite.Assert().ErrorIs(err, types.ErrInvalidSignature)
+	// the msg pays its signature check with the tx fees, the pairing gas is charged by the precompile only
+	gasConsumed := suite.Ctx.GasMeter().GasConsumed()
+	response, err := suite.rotateSignerKey(signer1, big.NewInt(2), 1)
+	suite.Require().NoError(err)
+	suite.Assert().Less(suite.Ctx.GasMeter().GasConsumed()-gasConsumed, params.GasSchedule.PairingCost)
+	suite.Assert().EqualValues(response.Nonce, 1)
+	suite.Assert().EqualValues(response.EffectiveEpoch, 2)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(events[len(events)-1].Type, types.EventTypeRotateSignerKey)
+	// the nonce is consumed
+	_, err = suite.rotateSignerKey(signer1, big.NewInt(2), 1)
+	suite.Assert().ErrorIs(err, types.ErrInvalidSignature)
+
+	// the key is not rotated before the next epoch
+	current, _, err := suite.Keeper.GetSigner(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(current.PubkeyG1, signer.PubkeyG1)
+
+	// register for epoch 2 with the old key, which is still active
+	hash := types.EpochRegistrationHash(common.HexToAddress(signer1), 2, big.NewInt(8888))
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   signer1,
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, big.NewInt(1))),
+	})
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 2)
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+
+	pkG1 := bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), big.NewInt(2)))
+	current, _, err = suite.Keeper.GetSigner(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(current.PubkeyG1, pkG1)
+	// quorums of epoch 1 keep resolving to the old key
+	suite.Assert().EqualValues(suite.aggregatePubkeyG1(1), signer.PubkeyG1)
+	suite.Assert().EqualValues(suite.aggregatePubkeyG1(2), pkG1)
+
+	// rotate again with the next nonce
+	response, err = suite.rotateSignerKey(signer1, big.NewInt(3), 2)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(response.Nonce, 2)
+	suite.Assert().EqualValues(response.EffectiveEpoch, 3)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 3)
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	suite.Assert().EqualValues(suite.aggregatePubkeyG1(1), signer.PubkeyG1)
+	suite.Assert().EqualValues(suite.aggregatePubkeyG1(2), pkG1)
+
+	// a jailed signer cannot rotate its key
+	suite.Require().NoError(suite.Keeper.SetJailedSigner(suite.Ctx, signer1, 3))
+	_, err = suite.rotateSignerKey(signer1, big.NewInt(4), 3)
+	suite.Assert().ErrorIs(err, types.ErrSignerJailed)
+}
+
+func (suite *KeeperTestSuite) daEvidence(epoch uint64, quorumId uint64, sk *big.Int) *types.MsgSubmitDAEvidence {
+	dataRoot := crypto.Keccak256([]byte("blob"))
+	signed := make([]*types.SignedCommitment, 2)
+	for i := range signed {
+		commitment := bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), big.NewInt(int64(i+1))))
+		hash := types.DABlobHash(dataRoot, epoch, quorumId, commitment)
+		signed[i] = &types.SignedCommitment{
+			Commitment: commitment,
+			Signature:  bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+		}
+	}
+	return &types.MsgSubmitDAEvidence{
+		Submitter: signer2,
+		Account:   signer1,
+		Epoch:     epoch,
+		QuorumId:  quorumId,
+		First:     signed[0],
+		Second:    signed[1],
+		DataRoot:  dataRoot,
+	}
+}
+
+func (suite *KeeperTestSuite) Test_SubmitDAEvidence() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	bonded := keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote * 10))
+	delegated := keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote * 5))
+	// signer1 operates a bonded validator with a third party delegation and delegates to the unbonded validator of signer2
+	thirdParty := "0000000000000000000000000000000000000003"
+	suite.AddDelegation(signer1, signer1, bonded)
+	suite.AddDelegation(thirdParty, signer1, delegated)
+	suite.AddDelegation(signer1, signer2, delegated)
+	accAddr, thirdPartyAddr := sdk.AccAddress(common.HexToAddress(signer1).Bytes()), sdk.AccAddress(common.HexToAddress(thirdParty).Bytes())
+	valAddr, otherValAddr := sdk.ValAddress(common.HexToAddress(signer1).Bytes()), sdk.ValAddress(common.HexToAddress(signer2).Bytes())
+	validator, found := suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	validator.Status = stakingtypes.Bonded
+	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
+	// AddDelegation bypasses the distribution hooks that unbonding relies on
+	distrHooks := suite.App.GetDistrKeeper().Hooks()
+	for _, addr := range []sdk.ValAddress{valAddr, otherValAddr} {
+		suite.Require().NoError(distrHooks.AfterValidatorCreated(suite.Ctx, addr))
+		suite.Require().NoError(distrHooks.AfterDelegationModified(suite.Ctx, accAddr, addr))
+	}
+	suite.Require().NoError(distrHooks.AfterDelegationModified(suite.Ctx, thirdPartyAddr, valAddr))
+	bondDenom := suite.StakingKeeper.BondDenom(suite.Ctx)
+	err := suite.App.FundModuleAccount(suite.Ctx, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, bonded.Add(delegated))))
+	suite.Require().NoError(err)
+	err = suite.App.FundModuleAccount(suite.Ctx, stakingtypes.NotBondedPoolName, sdk.NewCoins(sdk.NewCoin(bondDenom, delegated)))
+	suite.Require().NoError(err)
+	bondedPool := suite.App.GetModuleAccountBalance(suite.Ctx, stakingtypes.BondedPoolName, bondDenom)
+	notBondedPool := suite.App.GetModuleAccountBalance(suite.Ctx, stakingtypes.NotBondedPoolName, bondDenom)
+	suite.testRegisterSignerSuccess()
+	suite.testRegisterEpochSuccess()
+	suite.newEpoch(params)
+
+	// evidence of an epoch not generated yet
+	_, err = suite.Keeper.SubmitDAEvidence(sdk.WrapSDKContext(suite.Ctx), suite.daEvidence(2, 0, big.NewInt(1)))
+	suite.Assert().ErrorIs(err, types.ErrInvalidEvidence)
+	// signatures not made by the signer
+	_, err = suite.Keeper.SubmitDAEvidence(sdk.WrapSDKContext(suite.Ctx), suite.daEvidence(1, 0, big.NewInt(2)))
+	suite.Assert().ErrorIs(err, types.ErrInvalidSignature)
+
+	response, err := suite.Keeper.SubmitDAEvidence(sdk.WrapSDKContext(suite.Ctx), suite.daEvidence(1, 0, big.NewInt(1)))
+	suite.Require().NoError(err)
+	validatorSlashed := bonded.MulRaw(int64(params.SlashFractionBps)).QuoRaw(types.MaxBasisPoints)
+	delegationSlashed := delegated.MulRaw(int64(params.SlashFractionBps)).QuoRaw(types.MaxBasisPoints)
+	suite.Assert().EqualValues(validatorSlashed.Add(delegationSlashed), response.Slashed)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(events[len(events)-1].Type, types.EventTypeSlashSigner)
+
+	// only the shares of the signer are slashed on the validator it operates, the third party delegation is kept whole
+	validator, found = suite.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(bonded.Add(delegated).Sub(validatorSlashed), validator.Tokens)
+	delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, thirdPartyAddr, valAddr)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(delegated, validator.TokensFromShares(delegation.Shares).TruncateInt())
+	slashEvents := 0
+	suite.App.GetDistrKeeper().IterateValidatorSlashEventsBetween(suite.Ctx, valAddr, 0, uint64(suite.Ctx.BlockHeight()),
+		func(_ uint64, _ distrtypes.ValidatorSlashEvent) (stop bool) {
+			slashEvents += 1
+			return false
+		})
+	suite.Assert().EqualValues(0, slashEvents)
+	// only the shares of the signer are slashed on the validator of signer2
+	validator, found = suite.StakingKeeper.GetValidator(suite.Ctx, otherValAddr)
+	suite.Require().True(found)
+	suite.Assert().EqualValues(delegated.Sub(delegationSlashed), validator.Tokens)
+
+	// the tokens are burnt
+	stake, err := suite.Keeper.GetSignerStake(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(bonded.Sub(validatorSlashed).Add(delegated.Sub(delegationSlashed)), stake)
+	suite.Assert().EqualValues(bondedPool.Sub(validatorSlashed), suite.App.GetModuleAccountBalance(suite.Ctx, stakingtypes.BondedPoolName, bondDenom))
+	suite.Assert().EqualValues(notBondedPool.Sub(delegationSlashed), suite.App.GetModuleAccountBalance(suite.Ctx, stakingtypes.NotBondedPoolName, bondDenom))
+
+	// the signer is jailed from future quorums
+	jailEpoch, jailed, err := suite.Keeper.GetJailedSigner(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().True(jailed)
+	suite.Assert().EqualValues(jailEpoch, 1)
+	hash := types.EpochRegistrationHash(common.HexToAddress(signer1), 2, big.NewInt(8888))
+	_, err = suite.Keeper.RegisterNextEpoch(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterNextEpoch{
+		Account:   signer1,
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, big.NewInt(1))),
+	})
+	suite.Assert().ErrorIs(err, types.ErrSignerJailed)
+	// the same misbehaviour is not slashed twice
+	_, err = suite.Keeper.SubmitDAEvidence(sdk.WrapSDKContext(suite.Ctx), suite.daEvidence(1, 1, big.NewInt(1)))
+	suite.Assert().ErrorIs(err, types.ErrSignerJailed)
+	// jailing does not expire
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks) * 5)
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	_, jailed, err = suite.Keeper.GetJailedSigner(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().True(jailed)
+}
+
+func (suite *KeeperTestSuite) Test_UpdateParams() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	newParams := params
+	newParams.EncodedSlices = 1024
+	newParams.MaxQuorums = 20
+
+	_, err := suite.Keeper.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: suite.Addresses[0].String(),
+		Params:    newParams,
+	})
+	suite.Assert().ErrorIs(err, govtypes.ErrInvalidSigner)
+	invalidParams := newParams
+	invalidParams.EpochBlocks = 0
+	_, err = suite.Keeper.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: suite.Keeper.GetAuthority(),
+		Params:    invalidParams,
+	})
+	suite.Assert().Error(err)
+
+	_, err = suite.Keeper.UpdateParams(sdk.WrapSDKContext(suite.Ctx), &types.MsgUpdateParams{
+		Authority: suite.Keeper.GetAuthority(),
+		Params:    newParams,
+	})
+	suite.Require().NoError(err)
+	// not effective before the epoch boundary
+	suite.Assert().EqualValues(suite.Keeper.GetParams(suite.Ctx), params)
+	pending, found := suite.Keeper.GetPendingParams(suite.Ctx)
+	suite.Assert().True(found)
+	suite.Assert().EqualValues(pending, newParams)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(params.EpochBlocks))
+	suite.Keeper.BeginBlock(suite.Ctx, abci.RequestBeginBlock{})
+	suite.Assert().EqualValues(suite.Keeper.GetParams(suite.Ctx), newParams)
+	_, found = suite.Keeper.GetPendingParams(suite.Ctx)
+	suite.Assert().False(found)
+}
+
+func (suite *KeeperTestSuite) registerOperatedSigner(account string, operator string) error {
+	sk := big.NewInt(1)
+	hash := types.PubkeyRegistrationHash(common.HexToAddress(account), big.NewInt(8888))
+	_, err := suite.Keeper.RegisterSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgRegisterSigner{
+		Signer: &types.Signer{
+			Account:  account,
+			Socket:   "0.0.0.0:1234",
+			PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+			PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+		},
+		Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+		Operator:  operator,
+	})
+	return err
+}
+
+func (suite *KeeperTestSuite) Test_AuthorizeSigner() {
+	params := suite.Keeper.GetParams(suite.Ctx)
+	operator := signer2
+	signer3 := "9685C4EB29309820CDC62663CC6CC82F3D42E966"
+	bonded := keeper.BondedConversionRate.Mul(sdk.NewIntFromUint64(params.TokensPerVote))
+	suite.AddDelegation(operator, operator, bonded)
+	// the signer holds no delegations of its own
+	suite.Assert().ErrorIs(suite.registerOperatedSigner(signer1, ""), types.ErrInsufficientBonded)
+
+	response, err := suite.Keeper.AuthorizeSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgAuthorizeSigner{
+		Operator: operator,
+		Signer:   signer1,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues("", response.PreviousSigner)
+	events := suite.Ctx.EventManager().Events()
+	suite.Assert().EqualValues(events[len(events)-1], sdk.NewEvent(
+		types.EventTypeAuthorizeSigner,
+		sdk.NewAttribute(types.AttributeKeyOperator, operator),
+		sdk.NewAttribute(types.AttributeKeySigner, signer1),
+		sdk.NewAttribute(types.AttributeKeyPreviousSigner, ""),
+	))
+	// the authorization does not bind the signer before it accepts it
+	_, found, err := suite.Keeper.GetSignerOperator(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	suite.Assert().ErrorIs(suite.Keeper.CheckDelegations(suite.Ctx, signer1), types.ErrInsufficientBonded)
+	suite.Assert().ErrorIs(suite.registerOperatedSigner(signer1, ""), types.ErrInsufficientBonded)
+
+	// the signer accepts by naming the operator, an operator which did not authorize it cannot be named
+	suite.Assert().ErrorIs(suite.registerOperatedSigner(signer1, signer3), types.ErrInvalidOperator)
+	suite.Require().NoError(suite.registerOperatedSigner(signer1, operator))
+	stake, err := suite.Keeper.GetSignerStake(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(bonded, stake)
+	operatorResponse, err := suite.Keeper.SignerOperator(sdk.WrapSDKContext(suite.Ctx), &types.QuerySignerOperatorRequest{Account: signer1})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(strings.ToLower(operator), operatorResponse.Operator)
+	_, found, err = suite.Keeper.GetPendingAuthorization(suite.Ctx, operator)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+
+	// the accepted link cannot be taken over, and the operator cannot register itself
+	_, err = suite.Keeper.AuthorizeSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgAuthorizeSigner{
+		Operator: signer3,
+		Signer:   signer1,
+	})
+	suite.Assert().ErrorIs(err, types.ErrSignerAuthorized)
+	_, err = suite.Keeper.AuthorizeSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgAuthorizeSigner{
+		Operator: signer3,
+		Signer:   operator,
+	})
+	suite.Assert().ErrorIs(err, types.ErrInvalidOperator)
+	suite.Assert().ErrorIs(suite.registerOperatedSigner(operator, ""), types.ErrInvalidOperator)
+
+	// delegation changes of the operator are tracked on the signer
+	suite.AddDelegation(operator, operator, bonded)
+	operatorAddr, err := sdk.AccAddressFromHexUnsafe(operator)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.Keeper.Hooks().AfterDelegationModified(suite.Ctx, operatorAddr, nil))
+	stake, err = suite.Keeper.GetSignerStake(suite.Ctx, signer1)
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(bonded.MulRaw(2), stake)
+
+	// rotating the signer moves the stake to the new one once it accepts
+	response, err = suite.Keeper.AuthorizeSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgAuthorizeSigner{
+		Operator: operator,
+		Signer:   signer3,
+	})
+	suite.Require().NoError(err)
+	suite.Assert().EqualValues(strings.ToLower(signer1), response.PreviousSigner)
+	suite.Assert().NoError(suite.Keeper.CheckDelegations(suite.Ctx, signer1))
+	suite.Require().NoError(suite.registerOperatedSigner(signer3, operator))
+	suite.Assert().ErrorIs(suite.Keeper.CheckDelegations(suite.Ctx, signer1), types.ErrInsufficientBonded)
+	suite.Assert().NoError(suite.Keeper.CheckDelegations(suite.Ctx, signer3))
+	_, err = suite.Keeper.AuthorizeSigner(sdk.WrapSDKContext(suite.Ctx), &types.MsgAuthorizeSigner{
+		Operator: operator,
+		Signer:   signer1,
+	})
+	suite.Assert().ErrorIs(err, types.ErrSignerExists)
+
+	// removing the signer unlinks it from the operator
+	suite.Require().NoError(suite.Keeper.DeleteSigner(suite.Ctx, signer3))
+	_, found, err = suite.Keeper.GetSignerOperator(suite.Ctx, signer3)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
+	_, found, err = suite.Keeper.GetOperatorSigner(suite.Ctx, operator)
+	suite.Require().NoError(err)
+	suite.Assert().False(found)
 }
 
 func TestKeeperSuite(t *testing.T) {
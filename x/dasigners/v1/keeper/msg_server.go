@@ -1,11 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
 	etherminttypes "github.com/evmos/ethermint/types"
 )
@@ -14,8 +20,28 @@ var _ types.MsgServer = &Keeper{}
 
 func (k Keeper) RegisterSigner(goCtx context.Context, msg *types.MsgRegisterSigner) (*types.MsgRegisterSignerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the signer accepts the authorization of the operator it names, so an authorization cannot be forced onto it
+	if msg.Operator != "" {
+		if err := k.acceptAuthorization(ctx, msg.Operator, msg.Signer.Account); err != nil {
+			return nil, err
+		}
+	}
+	operator, _, err := k.GetSignerOperator(ctx, msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(operator, msg.Operator) {
+		return nil, errorsmod.Wrap(types.ErrInvalidOperator, "signer not authorized by operator")
+	}
+	_, isOperator, err := k.GetOperatorSigner(ctx, msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	if isOperator {
+		return nil, errorsmod.Wrap(types.ErrInvalidOperator, "account is an operator")
+	}
 	// validate sender
-	err := k.CheckDelegations(ctx, msg.Signer.Account)
+	err = k.CheckDelegations(ctx, msg.Signer.Account)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +52,13 @@ func (k Keeper) RegisterSigner(goCtx context.Context, msg *types.MsgRegisterSign
 	if found {
 		return nil, types.ErrSignerExists
 	}
+	_, jailed, err := k.GetJailedSigner(ctx, msg.Signer.Account)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, types.ErrSignerJailed
+	}
 	// validate signature
 	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
 	if err != nil {
@@ -39,6 +72,11 @@ func (k Keeper) RegisterSigner(goCtx context.Context, msg *types.MsgRegisterSign
 	if err := k.SetSigner(ctx, *msg.Signer); err != nil {
 		return nil, err
 	}
+	if k.hooks != nil {
+		if err := k.hooks.AfterSignerRegistered(ctx, *msg.Signer); err != nil {
+			return nil, err
+		}
+	}
 	return &types.MsgRegisterSignerResponse{}, nil
 }
 
@@ -81,11 +119,266 @@ func (k Keeper) RegisterNextEpoch(goCtx context.Context, msg *types.MsgRegisterN
 	if err != nil {
 		return nil, err
 	}
+	_, exiting, err := k.GetSignerExit(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	_, jailed, err := k.GetJailedSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, types.ErrSignerJailed
+	}
 	hash := types.EpochRegistrationHash(common.HexToAddress(msg.Account), epochNumber+1, chainID)
 	if !signer.ValidateSignature(hash, bn254util.DeserializeG1(msg.Signature)) {
 		return nil, types.ErrInvalidSignature
 	}
 	// save registration
-	k.SetRegistration(ctx, epochNumber+1, msg.Account, msg.Signature)
-	return &types.MsgRegisterNextEpochResponse{}, nil
+	if err := k.SetRegistration(ctx, epochNumber+1, msg.Account, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochRegistration{
+		Account: msg.Account,
+		Epoch:   epochNumber + 1,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNextEpochResponse{Epoch: epochNumber + 1}, nil
+}
+
+func (k Keeper) DeregisterSigner(goCtx context.Context, msg *types.MsgDeregisterSigner) (*types.MsgDeregisterSignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	_, exiting, err := k.GetSignerExit(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// drop the registration for the upcoming epoch, the signer is only kept in quorums already formed
+	if err := k.DeleteRegistration(ctx, epochNumber+1, msg.Account); err != nil {
+		return nil, err
+	}
+	// the signer may still sit in quorums of the current epoch, keep its record for the cooldown after that
+	exitEpoch := epochNumber + 1 + k.GetParams(ctx).ExitCooldownEpochs
+	if err := k.SetSignerExit(ctx, msg.Account, exitEpoch); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyExitEpoch, strconv.FormatUint(exitEpoch, 10)),
+		),
+	)
+	return &types.MsgDeregisterSignerResponse{ExitEpoch: exitEpoch}, nil
+}
+
+func (k Keeper) RotateSignerKey(goCtx context.Context, msg *types.MsgRotateSignerKey) (*types.MsgRotateSignerKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, found, err := k.GetSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	_, exiting, err := k.GetSignerExit(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if exiting {
+		return nil, types.ErrSignerExiting
+	}
+	_, jailed, err := k.GetJailedSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, types.ErrSignerJailed
+	}
+	// both keys are derived from the same secret, a rotation replaces both
+	if bytes.Equal(signer.PubkeyG1, msg.PubkeyG1) || bytes.Equal(signer.PubkeyG2, msg.PubkeyG2) {
+		return nil, types.ErrSignerKeyUnchanged
+	}
+	// each rotation signs a new nonce, so an earlier proof of possession cannot be replayed
+	nonce := uint64(1)
+	rotation, found, err := k.GetSignerKeyRotation(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		nonce = rotation.Nonce + 1
+	}
+	// validate signature
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	newSigner := types.Signer{
+		Account:  msg.Account,
+		PubkeyG1: msg.PubkeyG1,
+		PubkeyG2: msg.PubkeyG2,
+	}
+	hash := types.PubkeyRotationHash(common.HexToAddress(msg.Account), chainID, nonce)
+	if !newSigner.ValidateSignature(hash, bn254util.DeserializeG1(msg.Signature)) {
+		return nil, types.ErrInvalidSignature
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// quorums of the current epoch are formed already, the new key is used from the next epoch
+	effectiveEpoch := epochNumber + 1
+	if err := k.SetSignerKeyRotation(ctx, types.SignerKeyRotation{
+		Account:        msg.Account,
+		Nonce:          nonce,
+		PubkeyG1:       msg.PubkeyG1,
+		PubkeyG2:       msg.PubkeyG2,
+		EffectiveEpoch: effectiveEpoch,
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateSignerKey,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyPublicKeyG1, hex.EncodeToString(msg.PubkeyG1)),
+			sdk.NewAttribute(types.AttributeKeyPublicKeyG2, hex.EncodeToString(msg.PubkeyG2)),
+			sdk.NewAttribute(types.AttributeKeyNonce, strconv.FormatUint(nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveEpoch, strconv.FormatUint(effectiveEpoch, 10)),
+		),
+	)
+	return &types.MsgRotateSignerKeyResponse{Nonce: nonce, EffectiveEpoch: effectiveEpoch}, nil
+}
+
+func (k Keeper) SubmitDAEvidence(goCtx context.Context, msg *types.MsgSubmitDAEvidence) (*types.MsgSubmitDAEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, jailed, err := k.GetJailedSigner(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+	if jailed {
+		return nil, types.ErrSignerJailed
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Epoch > epochNumber {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidence, "epoch not generated")
+	}
+	if msg.Epoch < k.GetEarliestEpoch(ctx) {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidence, "epoch pruned")
+	}
+	// verify both signatures with the key the signer used in that epoch
+	pubkeyG1, pubkeyG2, found, err := k.GetSignerKeyAtEpoch(ctx, msg.Account, msg.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSignerNotFound
+	}
+	signer := types.Signer{
+		Account:  msg.Account,
+		PubkeyG1: pubkeyG1,
+		PubkeyG2: pubkeyG2,
+	}
+	// the DA signatures of a blob bind its data root to one erasure commitment, signing two is an equivocation
+	for _, signed := range []*types.SignedCommitment{msg.First, msg.Second} {
+		hash := types.DABlobHash(msg.DataRoot, msg.Epoch, msg.QuorumId, signed.Commitment)
+		if !signer.ValidateSignature(hash, bn254util.DeserializeG1(signed.Signature)) {
+			return nil, types.ErrInvalidSignature
+		}
+	}
+	// jail the signer from future quorums for good, quorums already formed are kept
+	if err := k.SetJailedSigner(ctx, msg.Account, epochNumber); err != nil {
+		return nil, err
+	}
+	if err := k.DeleteRegistration(ctx, epochNumber+1, msg.Account); err != nil {
+		return nil, err
+	}
+	fraction := sdk.NewDec(int64(k.GetParams(ctx).SlashFractionBps)).QuoInt64(types.MaxBasisPoints)
+	slashed, err := k.slashSigner(ctx, msg.Account, fraction)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(msg.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyQuorumId, strconv.FormatUint(msg.QuorumId, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashed, slashed.String()),
+		),
+	)
+	return &types.MsgSubmitDAEvidenceResponse{Slashed: slashed}, nil
+}
+
+func (k Keeper) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := k.claimRewards(ctx, msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Account),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return &types.MsgClaimRewardsResponse{Amount: amount}, nil
+}
+
+func (k Keeper) AuthorizeSigner(goCtx context.Context, msg *types.MsgAuthorizeSigner) (*types.MsgAuthorizeSignerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	previous, err := k.authorizeSigner(ctx, msg.Operator, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeSigner,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyPreviousSigner, previous),
+		),
+	)
+	return &types.MsgAuthorizeSignerResponse{PreviousSigner: previous}, nil
+}
+
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// params only change at the epoch boundary so one epoch is always generated with a single parameter set
+	k.SetPendingParams(ctx, msg.Params)
+	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// GetSignerOperator returns the operator which authorized the signer
+func (k Keeper) GetSignerOperator(ctx sdk.Context, account string) (string, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix)
+	key, err := types.GetSignerOperatorKey(account)
+	if err != nil {
+		return "", false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return "", false, nil
+	}
+	return hex.EncodeToString(bz), true, nil
+}
+
+// GetOperatorSigner returns the signer currently authorized by the operator
+func (k Keeper) GetOperatorSigner(ctx sdk.Context, operator string) (string, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OperatorSignerKeyPrefix)
+	key, err := types.GetOperatorSignerKey(operator)
+	if err != nil {
+		return "", false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return "", false, nil
+	}
+	return hex.EncodeToString(bz), true, nil
+}
+
+// SetSignerOperator links the signer and its operator in both directions
+func (k Keeper) SetSignerOperator(ctx sdk.Context, account string, operator string) error {
+	signerKey, err := types.GetSignerOperatorKey(account)
+	if err != nil {
+		return err
+	}
+	operatorKey, err := types.GetOperatorSignerKey(operator)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix).Set(signerKey, operatorKey)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.OperatorSignerKeyPrefix).Set(operatorKey, signerKey)
+	return nil
+}
+
+// DeleteSignerOperator unlinks the signer from its operator
+func (k Keeper) DeleteSignerOperator(ctx sdk.Context, account string) error {
+	operator, found, err := k.GetSignerOperator(ctx, account)
+	if err != nil || !found {
+		return err
+	}
+	signerKey, err := types.GetSignerOperatorKey(account)
+	if err != nil {
+		return err
+	}
+	operatorKey, err := types.GetOperatorSignerKey(operator)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix).Delete(signerKey)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.OperatorSignerKeyPrefix).Delete(operatorKey)
+	return nil
+}
+
+func (k Keeper) IterateSignerOperators(ctx sdk.Context, fn func(account string, operator string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerOperatorKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(hex.EncodeToString(iterator.Key()), hex.EncodeToString(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetStakeAccount returns the account whose delegations back the signer, the operator if the signer has one
+func (k Keeper) GetStakeAccount(ctx sdk.Context, account string) (string, error) {
+	operator, found, err := k.GetSignerOperator(ctx, account)
+	if err != nil {
+		return "", err
+	}
+	if found {
+		return operator, nil
+	}
+	return account, nil
+}
+
+// getBackedSigner returns the signer backed by the delegations of the delegator, which is the signer authorized
+// by the delegator if it is an operator, and the delegator itself unless an operator backs it
+func (k Keeper) getBackedSigner(ctx sdk.Context, delegator string) (string, bool, error) {
+	signer, found, err := k.GetOperatorSigner(ctx, delegator)
+	if err != nil || found {
+		return signer, found, err
+	}
+	_, operated, err := k.GetSignerOperator(ctx, delegator)
+	if err != nil {
+		return "", false, err
+	}
+	return delegator, !operated, nil
+}
+
+// GetPendingAuthorization returns the signer authorized by the operator which has not accepted the authorization yet
+func (k Keeper) GetPendingAuthorization(ctx sdk.Context, operator string) (string, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAuthorizationKeyPrefix)
+	key, err := types.GetPendingAuthorizationKey(operator)
+	if err != nil {
+		return "", false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return "", false, nil
+	}
+	return hex.EncodeToString(bz), true, nil
+}
+
+// SetPendingAuthorization records the signer authorized by the operator, replacing the previous pending one
+func (k Keeper) SetPendingAuthorization(ctx sdk.Context, operator string, account string) error {
+	key, err := types.GetPendingAuthorizationKey(operator)
+	if err != nil {
+		return err
+	}
+	bz, err := hex.DecodeString(account)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAuthorizationKeyPrefix).Set(key, bz)
+	return nil
+}
+
+func (k Keeper) DeletePendingAuthorization(ctx sdk.Context, operator string) error {
+	key, err := types.GetPendingAuthorizationKey(operator)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAuthorizationKeyPrefix).Delete(key)
+	return nil
+}
+
+func (k Keeper) IteratePendingAuthorizations(ctx sdk.Context, fn func(operator string, account string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAuthorizationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(hex.EncodeToString(iterator.Key()), hex.EncodeToString(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// checkAuthorization checks that the operator can back the signer with its delegations
+func (k Keeper) checkAuthorization(ctx sdk.Context, operator string, account string) error {
+	// the operator cannot back a signer while it is a signer itself, or its delegations would be counted twice
+	_, found, err := k.GetSigner(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errorsmod.Wrap(types.ErrInvalidOperator, "operator is a registered signer")
+	}
+	_, found, err = k.GetSignerOperator(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errorsmod.Wrap(types.ErrInvalidOperator, "operator is an authorized signer")
+	}
+	_, found, err = k.GetOperatorSigner(ctx, account)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errorsmod.Wrap(types.ErrInvalidOperator, "signer is an operator")
+	}
+	_, found, err = k.GetSignerOperator(ctx, account)
+	if err != nil {
+		return err
+	}
+	if found {
+		return types.ErrSignerAuthorized
+	}
+	// a registered signer is backed by its own delegations, it cannot be moved onto an operator
+	_, found, err = k.GetSigner(ctx, account)
+	if err != nil {
+		return err
+	}
+	if found {
+		return types.ErrSignerExists
+	}
+	return nil
+}
+
+// authorizeSigner records the authorization of the signer by the operator and returns the signer currently backed
+// by the operator. The authorization takes effect once the signer accepts it by registering with the operator, so
+// an operator cannot bind an account which did not consent to it.
+func (k Keeper) authorizeSigner(ctx sdk.Context, operator string, account string) (string, error) {
+	if err := k.checkAuthorization(ctx, operator, account); err != nil {
+		return "", err
+	}
+	if err := k.SetPendingAuthorization(ctx, operator, account); err != nil {
+		return "", err
+	}
+	previous, _, err := k.GetOperatorSigner(ctx, operator)
+	return previous, err
+}
+
+// acceptAuthorization makes the operator back the signer with its delegations if the operator authorized it, the
+// previous signer of the operator falls back to its own delegations
+func (k Keeper) acceptAuthorization(ctx sdk.Context, operator string, account string) error {
+	pending, found, err := k.GetPendingAuthorization(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if !found || !strings.EqualFold(pending, account) {
+		return errorsmod.Wrap(types.ErrInvalidOperator, "signer not authorized by operator")
+	}
+	// the accounts may have changed roles since the authorization
+	if err := k.checkAuthorization(ctx, operator, account); err != nil {
+		return err
+	}
+	previous, found, err := k.GetOperatorSigner(ctx, operator)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.DeleteSignerOperator(ctx, previous); err != nil {
+			return err
+		}
+		// the tracked stake of the previous signer was the stake of the operator
+		if err := k.DeleteSignerStake(ctx, previous); err != nil {
+			return err
+		}
+	}
+	if err := k.DeletePendingAuthorization(ctx, operator); err != nil {
+		return err
+	}
+	return k.SetSignerOperator(ctx, account, operator)
+}
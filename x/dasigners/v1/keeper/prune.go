@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// maxPrunedEpochsPerBlock bounds the pruning work of a single block, so lowering the retention
+// of a long history is spread over several blocks
+const maxPrunedEpochsPerBlock = 10
+
+// GetEarliestEpoch returns the oldest epoch whose history is kept
+func (k Keeper) GetEarliestEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EarliestEpochKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetEarliestEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EarliestEpochKey, sdk.Uint64ToBigEndian(epoch))
+}
+
+// pruneEpochHistory deletes the history of the epochs which fall out of the retention window
+func (k Keeper) pruneEpochHistory(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.HistoryRetentionEpochs == 0 {
+		return
+	}
+	epochNumber, err := k.GetEpochNumber(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if epochNumber <= params.HistoryRetentionEpochs {
+		return
+	}
+	retainFrom := epochNumber - params.HistoryRetentionEpochs
+	earliest := k.GetEarliestEpoch(ctx)
+	if earliest >= retainFrom {
+		return
+	}
+	if retainFrom-earliest > maxPrunedEpochsPerBlock {
+		retainFrom = earliest + maxPrunedEpochsPerBlock
+	}
+	for epoch := earliest; epoch < retainFrom; epoch += 1 {
+		k.deleteEpochHistory(ctx, epoch)
+	}
+	k.SetEarliestEpoch(ctx, retainFrom)
+	k.Logger(ctx).Info(fmt.Sprintf("[BeginBlock] epochs %v to %v pruned", earliest, retainFrom-1))
+}
+
+// deleteEpochHistory deletes the quorums, registrations, stake snapshots, seed, params and start height of the epoch
+func (k Keeper) deleteEpochHistory(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, p := range [][]byte{
+		types.GetEpochQuorumsKeyPrefix(epoch),
+		types.GetEpochRegistrationKeyPrefix(epoch),
+		types.GetEpochStakeKeyPrefix(epoch),
+	} {
+		deletePrefix(prefix.NewStore(store, p))
+	}
+	prefix.NewStore(store, types.QuorumCountKeyPrefix).Delete(types.GetQuorumCountKey(epoch))
+	prefix.NewStore(store, types.EpochSeedKeyPrefix).Delete(types.GetEpochSeedKey(epoch))
+	prefix.NewStore(store, types.EpochStartHeightKeyPrefix).Delete(types.GetEpochStartHeightKey(epoch))
+	prefix.NewStore(store, types.EpochParamsKeyPrefix).Delete(types.GetEpochParamsKey(epoch))
+}
+
+func deletePrefix(store prefix.Store) {
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
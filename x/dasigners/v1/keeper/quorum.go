@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// signerBallots is the ballot chain of a registered signer, the first ballot is mixed with the epoch seed
+// and every following ballot is the hash of the previous one
+type signerBallots struct {
+	account string
+	num     int64
+	first   []byte
+}
+
+// ballotLess orders ballots by content, the account breaks ties so the order never depends on the input order
+func ballotLess(a, b Ballot) bool {
+	if c := bytes.Compare(a.content, b.content); c != 0 {
+		return c < 0
+	}
+	return a.account < b.account
+}
+
+// ballotHeap is a max heap of ballots, the root is the largest ballot kept so far
+type ballotHeap []Ballot
+
+func (h ballotHeap) Len() int           { return len(h) }
+func (h ballotHeap) Less(i, j int) bool { return ballotLess(h[j], h[i]) }
+func (h ballotHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *ballotHeap) Push(x any) {
+	*h = append(*h, x.(Ballot))
+}
+
+func (h *ballotHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// quorumBallotLimit returns the number of smallest ballots that quorum formation reads.
+// With at least max_quorums full quorums only the first max_quorums * encoded_slices ballots are used,
+// otherwise every ballot is used and there are less than that many.
+func quorumBallotLimit(total int64, params types.Params) int64 {
+	if params.EncodedSlices > 0 && uint64(total)/params.EncodedSlices >= params.MaxQuorums {
+		return int64(params.MaxQuorums * params.EncodedSlices)
+	}
+	return total
+}
+
+// signerVotes returns the number of ballots of the signer, capped by max_votes_per_signer before any is hashed
+func signerVotes(signer signerBallots, params types.Params) int64 {
+	if signer.num <= 0 {
+		return 0
+	}
+	if uint64(signer.num) > params.MaxVotesPerSigner {
+		return int64(params.MaxVotesPerSigner)
+	}
+	return signer.num
+}
+
+// selectBallots streams the ballot chains of all signers and returns the smallest ballots needed for
+// quorum formation in ascending order. Memory is bounded by the ballot limit instead of the total number of ballots.
+// Every ballot within max_votes_per_signer is still hashed, so the hashing grows linearly with the number of signers.
+func selectBallots(signers []signerBallots, params types.Params) []Ballot {
+	total := int64(0)
+	for _, signer := range signers {
+		total += signerVotes(signer, params)
+	}
+	limit := int(quorumBallotLimit(total, params))
+	if limit == 0 {
+		return []Ballot{}
+	}
+	h := make(ballotHeap, 0, limit)
+	for _, signer := range signers {
+		content := signer.first
+		votes := signerVotes(signer, params)
+		for j := int64(0); j < votes; j += 1 {
+			ballot := Ballot{
+				account: signer.account,
+				content: content,
+			}
+			if len(h) < limit {
+				h = append(h, ballot)
+				if len(h) == limit {
+					heap.Init(&h)
+				}
+			} else if ballotLess(ballot, h[0]) {
+				h[0] = ballot
+				heap.Fix(&h, 0)
+			}
+			content = crypto.Keccak256(content)
+		}
+	}
+	ballots := []Ballot(h)
+	sort.Slice(ballots, func(i, j int) bool {
+		return ballotLess(ballots[i], ballots[j])
+	})
+	return ballots
+}
+
+// formQuorums chunks ballots sorted in ascending order into quorums of encoded_slices signers
+func formQuorums(ballots []Ballot, params types.Params) types.Quorums {
+	quorums := types.Quorums{
+		Quorums: make([]*types.Quorum, 0),
+	}
+	if len(ballots) >= int(params.EncodedSlices) {
+		for i := 0; i+int(params.EncodedSlices) <= len(ballots); i += int(params.EncodedSlices) {
+			if int(params.MaxQuorums) <= len(quorums.Quorums) {
+				break
+			}
+			quorum := types.Quorum{
+				Signers: make([]string, params.EncodedSlices),
+			}
+			for j := 0; j < int(params.EncodedSlices); j += 1 {
+				quorum.Signers[j] = ballots[i+j].account
+			}
+			quorums.Quorums = append(quorums.Quorums, &quorum)
+		}
+		if len(ballots)%int(params.EncodedSlices) != 0 && int(params.MaxQuorums) > len(quorums.Quorums) {
+			quorum := types.Quorum{
+				Signers: make([]string, 0),
+			}
+			for j := len(ballots) - int(params.EncodedSlices); j < len(ballots); j += 1 {
+				quorum.Signers = append(quorum.Signers, ballots[j].account)
+			}
+			quorums.Quorums = append(quorums.Quorums, &quorum)
+		}
+	} else if len(ballots) > 0 {
+		quorum := types.Quorum{
+			Signers: make([]string, params.EncodedSlices),
+		}
+		n := len(ballots)
+		for i := 0; i < int(params.EncodedSlices); i += 1 {
+			quorum.Signers[i] = ballots[i%n].account
+		}
+		quorums.Quorums = append(quorums.Quorums, &quorum)
+	}
+	return quorums
+}
+
+// checkQuorumParticipation returns the participation requirement missed by the quorums, or an empty string
+// if every quorum has enough distinct signers and no signer holds more than the maximum share of its rows
+func checkQuorumParticipation(quorums types.Quorums, params types.Params) string {
+	if len(quorums.Quorums) == 0 {
+		if params.MinQuorumSigners > 0 {
+			return "no quorum formed"
+		}
+		return ""
+	}
+	for quorumId, quorum := range quorums.Quorums {
+		rows := make(map[string]uint64)
+		maxRows := uint64(0)
+		for _, account := range quorum.Signers {
+			rows[account] += 1
+			if rows[account] > maxRows {
+				maxRows = rows[account]
+			}
+		}
+		if uint64(len(rows)) < params.MinQuorumSigners {
+			return fmt.Sprintf("quorum %v has %v distinct signers, below %v", quorumId, len(rows), params.MinQuorumSigners)
+		}
+		if params.MaxSignerShareBps > 0 && maxRows*types.MaxBasisPoints > params.MaxSignerShareBps*uint64(len(quorum.Signers)) {
+			return fmt.Sprintf("a signer holds %v of %v rows of quorum %v", maxRows, len(quorum.Signers), quorumId)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// sortedQuorums is the original quorum formation, it materializes and sorts every ballot
+func sortedQuorums(signers []signerBallots, params types.Params) types.Quorums {
+	ballots := []Ballot{}
+	for _, signer := range signers {
+		content := signer.first
+		for j := int64(0); j < signerVotes(signer, params); j += 1 {
+			ballots = append(ballots, Ballot{
+				account: signer.account,
+				content: content,
+			})
+			content = crypto.Keccak256(content)
+		}
+	}
+	sort.Slice(ballots, func(i, j int) bool {
+		return bytes.Compare(ballots[i].content, ballots[j].content) < 0
+	})
+	return formQuorums(ballots, params)
+}
+
+func randomSigners(r *rand.Rand, n int, maxVotes int64) []signerBallots {
+	signers := make([]signerBallots, n)
+	for i := range signers {
+		first := make([]byte, 32)
+		r.Read(first)
+		signers[i] = signerBallots{
+			account: fmt.Sprintf("%040x", i),
+			num:     r.Int63n(maxVotes + 1),
+			first:   first,
+		}
+	}
+	return signers
+}
+
+func TestSelectBallots_MatchesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, tc := range []struct {
+		signers       int
+		maxVotes      int64
+		encodedSlices uint64
+		maxQuorums    uint64
+	}{
+		{0, 10, 8, 2},
+		{1, 0, 8, 2},
+		{1, 3, 8, 2},
+		{3, 5, 8, 2},
+		{5, 10, 8, 10},
+		{20, 10, 8, 2},
+		{20, 10, 7, 100},
+		{100, 64, 32, 3},
+		{100, 64, 32, 1000},
+	} {
+		params := types.DefaultGenesisState().Params
+		params.EncodedSlices = tc.encodedSlices
+		params.MaxQuorums = tc.maxQuorums
+		for i := 0; i < 10; i += 1 {
+			signers := randomSigners(r, tc.signers, tc.maxVotes)
+			require.Equal(t, sortedQuorums(signers, params), formQuorums(selectBallots(signers, params), params),
+				"signers %v, max votes %v, encoded slices %v, max quorums %v", tc.signers, tc.maxVotes, tc.encodedSlices, tc.maxQuorums)
+		}
+	}
+}
+
+func TestSelectBallots_CapsVotes(t *testing.T) {
+	params := types.DefaultGenesisState().Params
+	params.MaxVotesPerSigner = 10
+	params.EncodedSlices = 8
+	params.MaxQuorums = 100
+	signers := randomSigners(rand.New(rand.NewSource(1)), 5, 100)
+	signers[0].num = 1 << 40
+	ballots := selectBallots(signers, params)
+	votes := make(map[string]int)
+	for _, ballot := range ballots {
+		votes[ballot.account] += 1
+	}
+	for _, signer := range signers {
+		require.LessOrEqual(t, votes[signer.account], int(params.MaxVotesPerSigner))
+	}
+	require.Equal(t, int(params.MaxVotesPerSigner), votes[signers[0].account])
+}
+
+func benchmarkQuorums(b *testing.B, numSigners int, form func([]signerBallots, types.Params) types.Quorums) {
+	params := types.DefaultGenesisState().Params
+	// signers asking for more votes than the cap hash max_votes_per_signer ballots only
+	signers := randomSigners(rand.New(rand.NewSource(1)), numSigners, 2*int64(params.MaxVotesPerSigner))
+	hashes := int64(0)
+	for _, signer := range signers {
+		hashes += signerVotes(signer, params)
+	}
+	if capped := int64(numSigners) * int64(params.MaxVotesPerSigner); hashes > capped {
+		b.Fatalf("%v ballots hashed, more than the %v capped votes", hashes, capped)
+	}
+	b.ReportMetric(float64(hashes), "hashes/op")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i += 1 {
+		form(signers, params)
+	}
+}
+
+func streamedQuorums(signers []signerBallots, params types.Params) types.Quorums {
+	return formQuorums(selectBallots(signers, params), params)
+}
+
+func BenchmarkQuorums(b *testing.B) {
+	for _, n := range []int{100, 1000, 5000} {
+		b.Run(fmt.Sprintf("sort/signers=%d", n), func(b *testing.B) {
+			benchmarkQuorums(b, n, sortedQuorums)
+		})
+		b.Run(fmt.Sprintf("stream/signers=%d", n), func(b *testing.B) {
+			benchmarkQuorums(b, n, streamedQuorums)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// rewardShare is the number of ballots a signer holds in an epoch
+type rewardShare struct {
+	account string
+	ballots int64
+}
+
+// GetSignerRewards returns the rewards allocated to the signer and not claimed yet
+func (k Keeper) GetSignerRewards(ctx sdk.Context, account string) (math.Int, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerRewardsKeyPrefix)
+	key, err := types.GetSignerRewardsKey(account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.Int{}, err
+	}
+	return amount, nil
+}
+
+func (k Keeper) SetSignerRewards(ctx sdk.Context, account string, amount math.Int) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerRewardsKeyPrefix)
+	key, err := types.GetSignerRewardsKey(account)
+	if err != nil {
+		return err
+	}
+	if !amount.IsPositive() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) IterateSignerRewards(ctx sdk.Context, fn func(account string, amount math.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerRewardsKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		if fn(hex.EncodeToString(iterator.Key()), amount) {
+			break
+		}
+	}
+}
+
+// GetOutstandingRewards returns the total rewards allocated to signers and not claimed yet
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OutstandingRewardsKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) SetOutstandingRewards(ctx sdk.Context, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.OutstandingRewardsKey, bz)
+}
+
+// GetRewardPool returns the balance of the reward pool which is not allocated to signers yet
+func (k Keeper) GetRewardPool(ctx sdk.Context) math.Int {
+	balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), k.stakingKeeper.BondDenom(ctx))
+	return balance.Amount.Sub(k.GetOutstandingRewards(ctx))
+}
+
+// collectRewards moves the configured share of the fees collected in the block to the reward pool
+func (k Keeper) collectRewards(ctx sdk.Context) error {
+	shareBps := k.GetParams(ctx).RewardFeeShareBps
+	if shareBps == 0 {
+		return nil
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	fees := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), bondDenom)
+	amount := fees.Amount.MulRaw(int64(shareBps)).QuoRaw(types.MaxBasisPoints)
+	if !amount.IsPositive() {
+		return nil
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+}
+
+// allocateEpochRewards splits the reward pool among the signers of the epoch in proportion to their ballots,
+// the rounding remainder stays in the pool for the next epoch
+func (k Keeper) allocateEpochRewards(ctx sdk.Context, epoch uint64, shares []rewardShare) error {
+	pool := k.GetRewardPool(ctx)
+	if !pool.IsPositive() {
+		return nil
+	}
+	totalBallots := int64(0)
+	for _, share := range shares {
+		totalBallots += share.ballots
+	}
+	if totalBallots == 0 {
+		return nil
+	}
+	allocated := math.ZeroInt()
+	for _, share := range shares {
+		amount := pool.MulRaw(share.ballots).QuoRaw(totalBallots)
+		if !amount.IsPositive() {
+			continue
+		}
+		rewards, err := k.GetSignerRewards(ctx, share.account)
+		if err != nil {
+			return err
+		}
+		if err := k.SetSignerRewards(ctx, share.account, rewards.Add(amount)); err != nil {
+			return err
+		}
+		allocated = allocated.Add(amount)
+	}
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Add(allocated))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAllocateRewards,
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, allocated.String()),
+		),
+	)
+	return nil
+}
+
+// claimRewards pays the rewards allocated to the signer out of the reward pool
+func (k Keeper) claimRewards(ctx sdk.Context, account string) (math.Int, error) {
+	rewards, err := k.GetSignerRewards(ctx, account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if !rewards.IsPositive() {
+		return math.Int{}, types.ErrNoRewards
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(account)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if err := k.SetSignerRewards(ctx, account, math.ZeroInt()); err != nil {
+		return math.Int{}, err
+	}
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Sub(rewards))
+	coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), rewards))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accAddr, coins); err != nil {
+		return math.Int{}, err
+	}
+	return rewards, nil
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetSignerKeyRotation(ctx sdk.Context, account string) (types.SignerKeyRotation, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyRotationPrefix)
+	key, err := types.GetSignerKeyRotationKey(account)
+	if err != nil {
+		return types.SignerKeyRotation{}, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SignerKeyRotation{}, false, nil
+	}
+	var rotation types.SignerKeyRotation
+	k.cdc.MustUnmarshal(bz, &rotation)
+	return rotation, true, nil
+}
+
+func (k Keeper) SetSignerKeyRotation(ctx sdk.Context, rotation types.SignerKeyRotation) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyRotationPrefix)
+	key, err := types.GetSignerKeyRotationKey(rotation.Account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&rotation))
+	return nil
+}
+
+func (k Keeper) DeleteSignerKeyRotation(ctx sdk.Context, account string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SignerKeyRotationPrefix)
+	key, err := types.GetSignerKeyRotationKey(account)
+	if err != nil {
+		return err
+	}
+	store.Delete(key)
+	return nil
+}
+
+// iterate through the latest key rotation of each signer and perform the provided function
+func (k Keeper) IterateSignerKeyRotations(ctx sdk.Context, fn func(rotation types.SignerKeyRotation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SignerKeyRotationPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rotation types.SignerKeyRotation
+		k.cdc.MustUnmarshal(iterator.Value(), &rotation)
+		if fn(rotation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) SetPreviousSignerKey(ctx sdk.Context, previousKey types.PreviousSignerKey) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PreviousSignerKeyPrefix)
+	key, err := types.GetPreviousSignerKeyKey(previousKey.Account, previousKey.LastEpoch)
+	if err != nil {
+		return err
+	}
+	store.Set(key, k.cdc.MustMarshal(&previousKey))
+	return nil
+}
+
+// iterate through the keys replaced by rotations and perform the provided function
+func (k Keeper) IteratePreviousSignerKeys(ctx sdk.Context, fn func(previousKey types.PreviousSignerKey) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PreviousSignerKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var previousKey types.PreviousSignerKey
+		k.cdc.MustUnmarshal(iterator.Value(), &previousKey)
+		if fn(previousKey) {
+			break
+		}
+	}
+}
+
+func (k Keeper) deletePreviousSignerKeys(ctx sdk.Context, account string) error {
+	accountPrefix, err := types.GetPreviousSignerKeyPrefix(account)
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.PreviousSignerKeyPrefix, accountPrefix...))
+	keys := make([][]byte, 0)
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetSignerKeyAtEpoch returns the G1 and G2 pubkeys the signer used in the given epoch,
+// keys replaced by a rotation keep resolving for the epochs before the rotation took effect
+func (k Keeper) GetSignerKeyAtEpoch(ctx sdk.Context, account string, epoch uint64) ([]byte, []byte, bool, error) {
+	accountPrefix, err := types.GetPreviousSignerKeyPrefix(account)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.PreviousSignerKeyPrefix, accountPrefix...))
+	// the first key whose last epoch is not before the given epoch
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(epoch), nil)
+	defer iterator.Close()
+	if iterator.Valid() {
+		var previousKey types.PreviousSignerKey
+		k.cdc.MustUnmarshal(iterator.Value(), &previousKey)
+		return previousKey.PubkeyG1, previousKey.PubkeyG2, true, nil
+	}
+	signer, found, err := k.GetSigner(ctx, account)
+	if err != nil || !found {
+		return nil, nil, found, err
+	}
+	return signer.PubkeyG1, signer.PubkeyG2, true, nil
+}
+
+// applySignerKeyRotations replaces the signer keys with the rotations taking effect from the given epoch
+func (k Keeper) applySignerKeyRotations(ctx sdk.Context, epoch uint64) error {
+	rotations := make([]types.SignerKeyRotation, 0)
+	k.IterateSignerKeyRotations(ctx, func(rotation types.SignerKeyRotation) (stop bool) {
+		if rotation.EffectiveEpoch == epoch {
+			rotations = append(rotations, rotation)
+		}
+		return false
+	})
+	for _, rotation := range rotations {
+		signer, found, err := k.GetSigner(ctx, rotation.Account)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		if err := k.SetPreviousSignerKey(ctx, types.PreviousSignerKey{
+			Account:   signer.Account,
+			LastEpoch: epoch - 1,
+			PubkeyG1:  signer.PubkeyG1,
+			PubkeyG2:  signer.PubkeyG2,
+		}); err != nil {
+			return err
+		}
+		signer.PubkeyG1 = rotation.PubkeyG1
+		signer.PubkeyG2 = rotation.PubkeyG2
+		if err := k.SetSigner(ctx, signer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func (k Keeper) GetJailedSigner(ctx sdk.Context, account string) (uint64, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailedSignerKeyPrefix)
+	key, err := types.GetJailedSignerKey(account)
+	if err != nil {
+		return 0, false, err
+	}
+	bz := store.Get(key)
+	if bz == nil {
+		return 0, false, nil
+	}
+	return sdk.BigEndianToUint64(bz), true, nil
+}
+
+// SetJailedSigner jails the signer for good, there is no unjail path and the record is neither pruned nor removed
+// with the signer, so that the account cannot register again
+func (k Keeper) SetJailedSigner(ctx sdk.Context, account string, jailEpoch uint64) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailedSignerKeyPrefix)
+	key, err := types.GetJailedSignerKey(account)
+	if err != nil {
+		return err
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(jailEpoch))
+	return nil
+}
+
+func (k Keeper) IterateJailedSigners(ctx sdk.Context, fn func(account string, jailEpoch uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.JailedSignerKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if fn(hex.EncodeToString(iterator.Key()), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// slashSigner slashes the given fraction of the stake backing the signer and returns the burnt amount. Only the shares
+// of the stake account are unbonded and burnt, also on a validator it operates, so that the other delegators of a
+// validator do not pay for the misbehaviour of the signer. This leaves the exchange rate of the validators unchanged
+// and fires the delegation hooks.
+func (k Keeper) slashSigner(ctx sdk.Context, account string, fraction sdk.Dec) (math.Int, error) {
+	slashed := math.ZeroInt()
+	if !fraction.IsPositive() {
+		return slashed, nil
+	}
+	stakeAccount, err := k.GetStakeAccount(ctx, account)
+	if err != nil {
+		return slashed, err
+	}
+	delegator, err := sdk.AccAddressFromHexUnsafe(stakeAccount)
+	if err != nil {
+		return slashed, err
+	}
+	// unbonding modifies the delegations, collect them first
+	delegations := make([]stakingtypes.Delegation, 0)
+	k.stakingKeeper.IterateDelegatorDelegations(ctx, delegator, func(delegation stakingtypes.Delegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, delegation := range delegations {
+		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return slashed, err
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if !found {
+			continue
+		}
+		shares := delegation.Shares.Mul(fraction)
+		if !shares.IsPositive() {
+			continue
+		}
+		// the tokens of unbonded shares stay in the pool of the validator status, burn them from there
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+		amount, err := k.stakingKeeper.Unbond(ctx, delegator, validatorAddr, shares)
+		if err != nil {
+			return slashed, err
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(bondDenom, amount))); err != nil {
+			return slashed, err
+		}
+		slashed = slashed.Add(amount)
+	}
+	return slashed, nil
+}
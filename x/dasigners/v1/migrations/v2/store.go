@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// MigrateStore performs in-place store migrations for consensus version 2
+// V2 adds the exit cooldown, slashing, history retention, reward, quorum diversity and gas schedule params.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	for _, key := range [][]byte{types.ParamsKey, types.PendingParamsKey} {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+		var params types.Params
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+		params = migrateParams(params)
+		if err := params.Validate(); err != nil {
+			return err
+		}
+		bz, err := cdc.Marshal(&params)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+	return nil
+}
+
+// migrateParams keeps the version 1 params and sets the new ones to their defaults
+func migrateParams(params types.Params) types.Params {
+	defaults := types.DefaultGenesisState().Params
+	params.ExitCooldownEpochs = defaults.ExitCooldownEpochs
+	params.SlashFractionBps = defaults.SlashFractionBps
+	params.HistoryRetentionEpochs = defaults.HistoryRetentionEpochs
+	params.RewardFeeShareBps = defaults.RewardFeeShareBps
+	params.MinQuorumSigners = defaults.MinQuorumSigners
+	params.MaxSignerShareBps = defaults.MaxSignerShareBps
+	params.GasSchedule = types.DefaultGasSchedule()
+	return params
+}
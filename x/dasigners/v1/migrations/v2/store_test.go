@@ -0,0 +1,48 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	v2dasigners "github.com/0glabs/0g-chain/x/dasigners/v1/migrations/v2"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func TestStoreMigrationSetsNewParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	dasignersKey := sdk.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(dasignersKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(dasignersKey)
+
+	// version 1 params only have the first five fields set
+	v1Params := types.Params{
+		TokensPerVote:     20,
+		MaxVotesPerSigner: 512,
+		MaxQuorums:        5,
+		EpochBlocks:       100,
+		EncodedSlices:     1024,
+	}
+	store.Set(types.ParamsKey, encCfg.Codec.MustMarshal(&v1Params))
+
+	// Run migrations.
+	err := v2dasigners.MigrateStore(ctx, dasignersKey, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the old params are kept and the new params are set.
+	var params types.Params
+	encCfg.Codec.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	expected := types.DefaultGenesisState().Params
+	expected.TokensPerVote = v1Params.TokensPerVote
+	expected.MaxVotesPerSigner = v1Params.MaxVotesPerSigner
+	expected.MaxQuorums = v1Params.MaxQuorums
+	expected.EpochBlocks = v1Params.EpochBlocks
+	expected.EncodedSlices = v1Params.EncodedSlices
+	require.Equal(t, expected, params)
+	require.NoError(t, params.Validate())
+	require.False(t, store.Has(types.PendingParamsKey))
+}
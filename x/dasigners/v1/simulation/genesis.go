@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// RandomizedParams generates random dasigners params, epochs are kept short so simulations roll over several of them
+func RandomizedParams(r *rand.Rand) types.Params {
+	return types.Params{
+		TokensPerVote:          uint64(r.Intn(100) + 1),
+		MaxVotesPerSigner:      uint64(r.Intn(64) + 1),
+		MaxQuorums:             uint64(r.Intn(10) + 1),
+		EpochBlocks:            uint64(r.Intn(20) + 1),
+		EncodedSlices:          uint64(r.Intn(32) + 1),
+		ExitCooldownEpochs:     uint64(r.Intn(3)),
+		SlashFractionBps:       uint64(r.Intn(int(types.MaxBasisPoints) + 1)),
+		HistoryRetentionEpochs: uint64(r.Intn(10)),
+		RewardFeeShareBps:      uint64(r.Intn(int(types.MaxBasisPoints) + 1)),
+		MinQuorumSigners:       uint64(r.Intn(3)),
+		MaxSignerShareBps:      uint64(r.Intn(int(types.MaxBasisPoints) + 1)),
+		GasSchedule:            types.DefaultGasSchedule(),
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for the dasigners module
+func RandomizedGenState(simState *module.SimulationState) {
+	gs := types.DefaultGenesisState()
+	gs.Params = RandomizedParams(simState.Rand)
+
+	bz, err := json.MarshalIndent(gs, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
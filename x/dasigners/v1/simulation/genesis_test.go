@@ -0,0 +1,39 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0glabs/0g-chain/x/dasigners/v1/simulation"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	for seed := int64(0); seed < 20; seed += 1 {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          cdc,
+			Rand:         r,
+			NumBonded:    3,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+		}
+		simulation.RandomizedGenState(&simState)
+
+		var gs types.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gs)
+		require.NoError(t, gs.Validate())
+		require.NoError(t, gs.Params.Validate())
+	}
+}
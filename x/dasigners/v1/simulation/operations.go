@@ -0,0 +1,231 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"math/rand"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	etherminttypes "github.com/evmos/ethermint/types"
+
+	"github.com/0glabs/0g-chain/crypto/bn254util"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/keeper"
+	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec // these are not hardcoded credentials
+const (
+	DefaultWeightMsgRegisterSigner    int = 50
+	DefaultWeightMsgUpdateSocket      int = 20
+	DefaultWeightMsgRegisterNextEpoch int = 100
+
+	OpWeightMsgRegisterSigner    = "op_weight_msg_register_signer"
+	OpWeightMsgUpdateSocket      = "op_weight_msg_update_socket"
+	OpWeightMsgRegisterNextEpoch = "op_weight_msg_register_next_epoch"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRegisterSigner    int
+		weightMsgUpdateSocket      int
+		weightMsgRegisterNextEpoch int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterSigner, &weightMsgRegisterSigner, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterSigner = DefaultWeightMsgRegisterSigner
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateSocket, &weightMsgUpdateSocket, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateSocket = DefaultWeightMsgUpdateSocket
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterNextEpoch, &weightMsgRegisterNextEpoch, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterNextEpoch = DefaultWeightMsgRegisterNextEpoch
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRegisterSigner,
+			SimulateMsgRegisterSigner(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateSocket,
+			SimulateMsgUpdateSocket(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRegisterNextEpoch,
+			SimulateMsgRegisterNextEpoch(ak, bk, k),
+		),
+	}
+}
+
+// signerSecretKey derives the BN254 secret key of a simulation account, so later operations can sign for a
+// signer registered by an earlier one without keeping state between operations
+func signerSecretKey(address sdk.AccAddress) *big.Int {
+	sk := new(big.Int).SetBytes(crypto.Keccak256(address))
+	return sk.Mod(sk, bn254util.FR_MODULUS)
+}
+
+func randomSocket(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, 10) + ":" + big.NewInt(int64(simtypes.RandIntBetween(r, 1, 65536))).String()
+}
+
+// SimulateMsgRegisterSigner generates a MsgRegisterSigner for a random account with bonded tokens
+func SimulateMsgRegisterSigner(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterSigner{})
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := hex.EncodeToString(simAccount.Address)
+
+		_, found, err := k.GetSigner(ctx, account)
+		if err != nil || found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "signer already exists"), nil, nil
+		}
+		if _, jailed, _ := k.GetJailedSigner(ctx, account); jailed {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "signer is jailed"), nil, nil
+		}
+		if err := k.CheckDelegations(ctx, account); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient bonded tokens"), nil, nil
+		}
+		chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "chain id is not an ethermint chain id"), nil, nil
+		}
+
+		sk := signerSecretKey(simAccount.Address)
+		hash := types.PubkeyRegistrationHash(common.HexToAddress(account), chainID)
+		msg := &types.MsgRegisterSigner{
+			Signer: &types.Signer{
+				Account:  account,
+				Socket:   randomSocket(r),
+				PubkeyG1: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)),
+				PubkeyG2: bn254util.SerializeG2(new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)),
+			},
+			Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, sk)),
+		}
+		return deliverMsg(r, app, ctx, simAccount, ak, bk, msg)
+	}
+}
+
+// SimulateMsgUpdateSocket generates a MsgUpdateSocket with a random socket for a random registered signer
+func SimulateMsgUpdateSocket(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgUpdateSocket{})
+		simAccount, found := randomSigner(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no registered signer"), nil, nil
+		}
+		msg := &types.MsgUpdateSocket{
+			Account: hex.EncodeToString(simAccount.Address),
+			Socket:  randomSocket(r),
+		}
+		return deliverMsg(r, app, ctx, simAccount, ak, bk, msg)
+	}
+}
+
+// SimulateMsgRegisterNextEpoch generates a MsgRegisterNextEpoch for a random registered signer
+func SimulateMsgRegisterNextEpoch(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterNextEpoch{})
+		simAccount, found := randomSigner(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no registered signer"), nil, nil
+		}
+		account := hex.EncodeToString(simAccount.Address)
+		if _, exiting, _ := k.GetSignerExit(ctx, account); exiting {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "signer is exiting"), nil, nil
+		}
+		if _, jailed, _ := k.GetJailedSigner(ctx, account); jailed {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "signer is jailed"), nil, nil
+		}
+		if err := k.CheckDelegations(ctx, account); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient bonded tokens"), nil, nil
+		}
+		epochNumber, err := k.GetEpochNumber(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "epoch number not found"), nil, err
+		}
+		if _, registered, _ := k.GetRegistration(ctx, epochNumber+1, account); registered {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "already registered for next epoch"), nil, nil
+		}
+		chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "chain id is not an ethermint chain id"), nil, nil
+		}
+
+		hash := types.EpochRegistrationHash(common.HexToAddress(account), epochNumber+1, chainID)
+		msg := &types.MsgRegisterNextEpoch{
+			Account:   account,
+			Signature: bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, signerSecretKey(simAccount.Address))),
+		}
+		return deliverMsg(r, app, ctx, simAccount, ak, bk, msg)
+	}
+}
+
+// randomSigner picks a random simulation account registered as a signer, signers whose key was rotated
+// outside the simulation cannot be signed for and are skipped
+func randomSigner(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	candidates := make([]simtypes.Account, 0)
+	for _, acc := range accs {
+		signer, found, err := k.GetSigner(ctx, hex.EncodeToString(acc.Address))
+		if err != nil || !found {
+			continue
+		}
+		pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), signerSecretKey(acc.Address))
+		if !bytes.Equal(signer.PubkeyG1, bn254util.SerializeG1(pkG1)) {
+			continue
+		}
+		candidates = append(candidates, acc)
+	}
+	if len(candidates) == 0 {
+		return simtypes.Account{}, false
+	}
+	return candidates[r.Intn(len(candidates))], true
+}
+
+func deliverMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, simAccount simtypes.Account,
+	ak types.AccountKeeper, bk types.BankKeeper, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -79,10 +79,16 @@ func (suite *Suite) AddDelegation(from string, to string, amount math.Int) {
 	validator.Tokens = validator.Tokens.Add(amount)
 	validator.DelegatorShares = validator.DelegatorShares.Add(amount.ToLegacyDec())
 	suite.StakingKeeper.SetValidator(suite.Ctx, validator)
-	bonded := suite.Keeper.GetDelegatorBonded(suite.Ctx, accAddr)
+	shares := amount.ToLegacyDec()
+	if delegation, found := suite.StakingKeeper.GetDelegation(suite.Ctx, accAddr, valAddr); found {
+		shares = shares.Add(delegation.Shares)
+	}
 	suite.StakingKeeper.SetDelegation(suite.Ctx, stakingtypes.Delegation{
 		DelegatorAddress: accAddr.String(),
 		ValidatorAddress: valAddr.String(),
-		Shares:           bonded.Add(amount).ToLegacyDec(),
+		Shares:           shares,
 	})
+	// SetDelegation bypasses the staking hooks
+	err = suite.Keeper.Hooks().AfterDelegationModified(suite.Ctx, accAddr, valAddr)
+	suite.Require().NoError(err)
 }
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"github.com/0glabs/0g-chain/crypto/bls"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
@@ -34,11 +36,22 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterSigner{},
 		&MsgUpdateSocket{},
 		&MsgRegisterNextEpoch{},
+		&MsgDeregisterSigner{},
+		&MsgUpdateParams{},
+		&MsgRotateSignerKey{},
+		&MsgSubmitDAEvidence{},
+		&MsgClaimRewards{},
+		&MsgAuthorizeSigner{},
 	)
 
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &bls.PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &bls.PrivKey{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&bls.PubKey{}, bls.PubKeyName, nil)
+	cdc.RegisterConcrete(&bls.PrivKey{}, bls.PrivKeyName, nil)
 }
@@ -12,4 +12,15 @@ var (
 	ErrQuorumBitmapLengthMismatch = errorsmod.Register(ModuleName, 7, "quorum bitmap length mismatch")
 	ErrInsufficientBonded         = errorsmod.Register(ModuleName, 8, "insufficient bonded amount")
 	ErrRowIndexOutOfBound         = errorsmod.Register(ModuleName, 9, "row index out of bound")
+	ErrSignerExiting              = errorsmod.Register(ModuleName, 10, "signer is exiting")
+	ErrEpochStakeNotFound         = errorsmod.Register(ModuleName, 11, "stake snapshot for epoch not found")
+	ErrInvalidMessageHash         = errorsmod.Register(ModuleName, 12, "invalid message hash")
+	ErrSignerKeyUnchanged         = errorsmod.Register(ModuleName, 13, "signer key unchanged")
+	ErrEpochSeedNotFound          = errorsmod.Register(ModuleName, 14, "seed for epoch not found")
+	ErrSignerJailed               = errorsmod.Register(ModuleName, 15, "signer is jailed")
+	ErrInvalidEvidence            = errorsmod.Register(ModuleName, 16, "invalid evidence")
+	ErrNoRewards                  = errorsmod.Register(ModuleName, 17, "no pending rewards")
+	ErrEpochParamsNotFound        = errorsmod.Register(ModuleName, 18, "params for epoch not found")
+	ErrSignerAuthorized           = errorsmod.Register(ModuleName, 19, "signer already authorized")
+	ErrInvalidOperator            = errorsmod.Register(ModuleName, 20, "invalid signer operator")
 )
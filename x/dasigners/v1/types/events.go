@@ -2,10 +2,27 @@ package types
 
 // Module event types
 const (
-	EventTypeUpdateSigner = "update_signer"
+	EventTypeUpdateSigner     = "update_signer"
+	EventTypeDeregisterSigner = "deregister_signer"
+	EventTypeRemoveSigner     = "remove_signer"
+	EventTypeRotateSignerKey  = "rotate_signer_key"
+	EventTypeSlashSigner      = "slash_signer"
+	EventTypeAllocateRewards  = "allocate_rewards"
+	EventTypeClaimRewards     = "claim_rewards"
+	EventTypeAuthorizeSigner  = "authorize_signer"
 
-	AttributeKeySigner      = "signer"
-	AttributeKeySocket      = "socket"
-	AttributeKeyPublicKeyG1 = "pubkey_g1"
-	AttributeKeyPublicKeyG2 = "pubkey_g2"
+	AttributeKeySigner         = "signer"
+	AttributeKeySocket         = "socket"
+	AttributeKeyPublicKeyG1    = "pubkey_g1"
+	AttributeKeyPublicKeyG2    = "pubkey_g2"
+	AttributeKeyExitEpoch      = "exit_epoch"
+	AttributeKeyNonce          = "nonce"
+	AttributeKeyEffectiveEpoch = "effective_epoch"
+	AttributeKeyEpoch          = "epoch"
+	AttributeKeyQuorumId       = "quorum_id"
+	AttributeKeySubmitter      = "submitter"
+	AttributeKeySlashed        = "slashed"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyOperator       = "operator"
+	AttributeKeyPreviousSigner = "previous_signer"
 )
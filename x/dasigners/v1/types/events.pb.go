@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zgc/dasigners/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventNewEpoch is emitted when the quorums of a new epoch are generated
+type EventNewEpoch struct {
+	// epoch defines the number of the generated epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// quorum_count defines the number of quorums formed for the epoch
+	QuorumCount uint64 `protobuf:"varint,2,opt,name=quorum_count,json=quorumCount,proto3" json:"quorum_count,omitempty"`
+	// seed defines the randomness mixed into the ballots of the epoch
+	Seed []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *EventNewEpoch) Reset()         { *m = EventNewEpoch{} }
+func (m *EventNewEpoch) String() string { return proto.CompactTextString(m) }
+func (*EventNewEpoch) ProtoMessage()    {}
+func (*EventNewEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b24a66c6434b0bc, []int{0}
+}
+func (m *EventNewEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNewEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewEpoch.Merge(m, src)
+}
+func (m *EventNewEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNewEpoch proto.InternalMessageInfo
+
+func (m *EventNewEpoch) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventNewEpoch) GetQuorumCount() uint64 {
+	if m != nil {
+		return m.QuorumCount
+	}
+	return 0
+}
+
+func (m *EventNewEpoch) GetSeed() []byte {
+	if m != nil {
+		return m.Seed
+	}
+	return nil
+}
+
+// EventEpochRegistration is emitted when a signer registers for the next epoch
+type EventEpochRegistration struct {
+	// account defines the hex address of the signer without 0x
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// epoch defines the epoch the signer registered for
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *EventEpochRegistration) Reset()         { *m = EventEpochRegistration{} }
+func (m *EventEpochRegistration) String() string { return proto.CompactTextString(m) }
+func (*EventEpochRegistration) ProtoMessage()    {}
+func (*EventEpochRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b24a66c6434b0bc, []int{1}
+}
+func (m *EventEpochRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochRegistration.Merge(m, src)
+}
+func (m *EventEpochRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochRegistration proto.InternalMessageInfo
+
+func (m *EventEpochRegistration) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventEpochRegistration) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// EventQuorumsCarriedForward is emitted when the quorums formed for a new epoch miss the participation
+// requirements and the quorums of the previous epoch are kept instead
+type EventQuorumsCarriedForward struct {
+	// epoch defines the number of the generated epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// from_epoch defines the epoch whose quorums are carried forward
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// reason defines the participation requirement the formed quorums missed
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// dropped_quorums defines the number of quorums of from_epoch not carried forward because one of their signers
+	// is jailed or exited
+	DroppedQuorums uint64 `protobuf:"varint,4,opt,name=dropped_quorums,json=droppedQuorums,proto3" json:"dropped_quorums,omitempty"`
+}
+
+func (m *EventQuorumsCarriedForward) Reset()         { *m = EventQuorumsCarriedForward{} }
+func (m *EventQuorumsCarriedForward) String() string { return proto.CompactTextString(m) }
+func (*EventQuorumsCarriedForward) ProtoMessage()    {}
+func (*EventQuorumsCarriedForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b24a66c6434b0bc, []int{2}
+}
+func (m *EventQuorumsCarriedForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQuorumsCarriedForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQuorumsCarriedForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQuorumsCarriedForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuorumsCarriedForward.Merge(m, src)
+}
+func (m *EventQuorumsCarriedForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQuorumsCarriedForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuorumsCarriedForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQuorumsCarriedForward proto.InternalMessageInfo
+
+func (m *EventQuorumsCarriedForward) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventQuorumsCarriedForward) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *EventQuorumsCarriedForward) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventQuorumsCarriedForward) GetDroppedQuorums() uint64 {
+	if m != nil {
+		return m.DroppedQuorums
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventNewEpoch)(nil), "zgc.dasigners.v1.EventNewEpoch")
+	proto.RegisterType((*EventEpochRegistration)(nil), "zgc.dasigners.v1.EventEpochRegistration")
+	proto.RegisterType((*EventQuorumsCarriedForward)(nil), "zgc.dasigners.v1.EventQuorumsCarriedForward")
+}
+
+func init() { proto.RegisterFile("zgc/dasigners/v1/events.proto", fileDescriptor_6b24a66c6434b0bc) }
+
+var fileDescriptor_6b24a66c6434b0bc = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4e, 0x02, 0x31,
+	0x10, 0xc6, 0x29, 0x22, 0x86, 0x11, 0xff, 0xa4, 0x31, 0x64, 0x63, 0xc2, 0x06, 0xb9, 0xc8, 0xc5,
+	0x2d, 0xc4, 0x37, 0x90, 0x60, 0xf4, 0x62, 0xe2, 0x1e, 0x8d, 0x09, 0x29, 0xdd, 0xb1, 0x6c, 0x22,
+	0xdb, 0xb5, 0xed, 0x82, 0xf2, 0x14, 0xc6, 0xa7, 0xf2, 0xc8, 0xd1, 0xa3, 0x81, 0x17, 0x31, 0xdb,
+	0x45, 0xc5, 0x83, 0xb7, 0xf9, 0xbe, 0x69, 0xbf, 0xf9, 0x4d, 0x06, 0x9a, 0x73, 0x29, 0x58, 0xc4,
+	0x4d, 0x2c, 0x13, 0xd4, 0x86, 0x4d, 0x7b, 0x0c, 0xa7, 0x98, 0x58, 0x13, 0xa4, 0x5a, 0x59, 0x45,
+	0x0f, 0xe7, 0x52, 0x04, 0x3f, 0xed, 0x60, 0xda, 0x6b, 0xdf, 0xc3, 0xde, 0x20, 0x7f, 0x71, 0x83,
+	0xb3, 0x41, 0xaa, 0xc4, 0x98, 0x1e, 0xc1, 0x36, 0xe6, 0x85, 0x47, 0x5a, 0xa4, 0x53, 0x09, 0x0b,
+	0x41, 0x4f, 0xa0, 0xfe, 0x94, 0x29, 0x9d, 0x4d, 0x86, 0x42, 0x65, 0x89, 0xf5, 0xca, 0xae, 0xb9,
+	0x5b, 0x78, 0xfd, 0xdc, 0xa2, 0x14, 0x2a, 0x06, 0x31, 0xf2, 0xb6, 0x5a, 0xa4, 0x53, 0x0f, 0x5d,
+	0xdd, 0xbe, 0x82, 0x86, 0x4b, 0x77, 0xd1, 0x21, 0xca, 0xd8, 0x58, 0xcd, 0x6d, 0xac, 0x12, 0xea,
+	0xc1, 0x0e, 0x17, 0x45, 0x56, 0x3e, 0xa8, 0x16, 0x7e, 0xcb, 0x5f, 0x80, 0xf2, 0x06, 0x40, 0xfb,
+	0x8d, 0xc0, 0xb1, 0x8b, 0xba, 0x75, 0x23, 0x4d, 0x9f, 0x6b, 0x1d, 0x63, 0x74, 0xa9, 0xf4, 0x8c,
+	0xeb, 0xe8, 0x1f, 0xea, 0x26, 0xc0, 0x83, 0x56, 0x93, 0xe1, 0x66, 0x5e, 0x2d, 0x77, 0x8a, 0x55,
+	0x1b, 0x50, 0xd5, 0xc8, 0x8d, 0x4a, 0x1c, 0x73, 0x2d, 0x5c, 0x2b, 0x7a, 0x0a, 0x07, 0x91, 0x56,
+	0x69, 0x8a, 0xd1, 0xb0, 0x58, 0xd0, 0x78, 0x15, 0xf7, 0x77, 0x7f, 0x6d, 0xaf, 0x19, 0x2e, 0xae,
+	0xdf, 0x97, 0x3e, 0x59, 0x2c, 0x7d, 0xf2, 0xb9, 0xf4, 0xc9, 0xeb, 0xca, 0x2f, 0x2d, 0x56, 0x7e,
+	0xe9, 0x63, 0xe5, 0x97, 0xee, 0x98, 0x8c, 0xed, 0x38, 0x1b, 0x05, 0x42, 0x4d, 0x58, 0x57, 0x3e,
+	0xf2, 0x91, 0x61, 0x5d, 0x79, 0x26, 0xc6, 0x3c, 0x4e, 0xd8, 0xf3, 0xdf, 0x03, 0xd9, 0x97, 0x14,
+	0xcd, 0xa8, 0xea, 0x0e, 0x74, 0xfe, 0x35, 0x00, 0xf2, 0x9c, 0xf8, 0x5e, 0xc1, 0x01, 0x00, 0x00,
+}
+
+func (m *EventNewEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuorumCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QuorumCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuorumsCarriedForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuorumsCarriedForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuorumsCarriedForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DroppedQuorums != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DroppedQuorums))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventNewEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	if m.QuorumCount != 0 {
+		n += 1 + sovEvents(uint64(m.QuorumCount))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEpochRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *EventQuorumsCarriedForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEvents(uint64(m.Epoch))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovEvents(uint64(m.FromEpoch))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DroppedQuorums != 0 {
+		n += 1 + sovEvents(uint64(m.DroppedQuorums))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventNewEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumCount", wireType)
+			}
+			m.QuorumCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventQuorumsCarriedForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventQuorumsCarriedForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventQuorumsCarriedForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedQuorums", wireType)
+			}
+			m.DroppedQuorums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedQuorums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
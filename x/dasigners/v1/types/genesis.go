@@ -1,32 +1,51 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(params Params, epoch uint64, signers []*Signer, quorumsByEpoch []*Quorums) *GenesisState {
+func NewGenesisState(params Params, epoch uint64, signers []*Signer, quorumsByEpoch []*Quorums, signerExits []*SignerExit) *GenesisState {
 	return &GenesisState{
 		Params:         params,
 		EpochNumber:    epoch,
 		Signers:        signers,
 		QuorumsByEpoch: quorumsByEpoch,
+		SignerExits:    signerExits,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state for the module.
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(Params{
-		TokensPerVote:     10,
-		MaxVotesPerSigner: 1024,
-		MaxQuorums:        10,
-		EpochBlocks:       5760,
-		EncodedSlices:     3072,
+		TokensPerVote:          10,
+		MaxVotesPerSigner:      1024,
+		MaxQuorums:             10,
+		EpochBlocks:            5760,
+		EncodedSlices:          3072,
+		ExitCooldownEpochs:     2,
+		SlashFractionBps:       500,
+		HistoryRetentionEpochs: 30,
+		RewardFeeShareBps:      1000,
+		MinQuorumSigners:       2,
+		MaxSignerShareBps:      7000,
+		GasSchedule:            DefaultGasSchedule(),
 	}, 0, make([]*Signer, 0), []*Quorums{{
 		Quorums: make([]*Quorum, 0),
-	}})
+	}}, make([]*SignerExit, 0))
 }
 
 // Validate performs basic validation of genesis data.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if gs.PendingParams != nil {
+		if err := gs.PendingParams.Validate(); err != nil {
+			return err
+		}
+	}
 	registered := make(map[string]struct{})
 	for _, signer := range gs.Signers {
 		if err := signer.Validate(); err != nil {
@@ -34,20 +53,179 @@ func (gs GenesisState) Validate() error {
 		}
 		registered[signer.Account] = struct{}{}
 	}
-	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber)+1 {
+	if gs.EarliestEpoch > gs.EpochNumber {
+		return fmt.Errorf("earliest epoch after current epoch")
+	}
+	if len(gs.QuorumsByEpoch) != int(gs.EpochNumber-gs.EarliestEpoch)+1 {
 		return fmt.Errorf("epoch history missing")
 	}
-	for _, quorums := range gs.QuorumsByEpoch {
+	for i, quorums := range gs.QuorumsByEpoch {
+		epoch := gs.EarliestEpoch + uint64(i)
 		for _, quorum := range quorums.Quorums {
 			for _, signer := range quorum.Signers {
 				if err := ValidateHexAddress(signer); err != nil {
 					return err
 				}
-				if _, ok := registered[signer]; !ok {
-					return fmt.Errorf("historical signer detail missing")
+				// signers of older epochs may have been removed after their exit cooldown
+				if _, ok := registered[signer]; !ok && epoch == gs.EpochNumber {
+					return fmt.Errorf("current signer detail missing")
 				}
 			}
 		}
 	}
+	exiting := make(map[string]struct{})
+	for _, exit := range gs.SignerExits {
+		if _, ok := registered[exit.Account]; !ok {
+			return fmt.Errorf("exiting signer detail missing")
+		}
+		if _, ok := exiting[exit.Account]; ok {
+			return fmt.Errorf("duplicate signer exit")
+		}
+		exiting[exit.Account] = struct{}{}
+	}
+	rotated := make(map[string]struct{})
+	for _, rotation := range gs.SignerKeyRotations {
+		if err := rotation.Validate(); err != nil {
+			return err
+		}
+		if _, ok := registered[rotation.Account]; !ok {
+			return fmt.Errorf("rotated signer detail missing")
+		}
+		if _, ok := rotated[rotation.Account]; ok {
+			return fmt.Errorf("duplicate signer key rotation")
+		}
+		rotated[rotation.Account] = struct{}{}
+	}
+	for _, previousKey := range gs.PreviousSignerKeys {
+		if err := previousKey.Validate(); err != nil {
+			return err
+		}
+		if _, ok := rotated[previousKey.Account]; !ok {
+			return fmt.Errorf("previous signer key without rotation")
+		}
+	}
+	jailed := make(map[string]struct{})
+	for _, jail := range gs.JailedSigners {
+		if err := ValidateHexAddress(jail.Account); err != nil {
+			return err
+		}
+		if _, ok := jailed[jail.Account]; ok {
+			return fmt.Errorf("duplicate jailed signer")
+		}
+		jailed[jail.Account] = struct{}{}
+	}
+	rewarded := make(map[string]struct{})
+	for _, rewards := range gs.SignerRewards {
+		if err := ValidateHexAddress(rewards.Account); err != nil {
+			return err
+		}
+		if !rewards.Amount.IsPositive() {
+			return fmt.Errorf("signer rewards must be positive")
+		}
+		if _, ok := rewarded[rewards.Account]; ok {
+			return fmt.Errorf("duplicate signer rewards")
+		}
+		rewarded[rewards.Account] = struct{}{}
+	}
+	seeded := make(map[uint64]struct{})
+	for _, seed := range gs.EpochSeeds {
+		if seed.EpochNumber > gs.EpochNumber {
+			return fmt.Errorf("seed of future epoch")
+		}
+		if seed.EpochNumber < gs.EarliestEpoch {
+			return fmt.Errorf("seed of pruned epoch")
+		}
+		if len(seed.Seed) == 0 {
+			return fmt.Errorf("empty epoch seed")
+		}
+		if _, ok := seeded[seed.EpochNumber]; ok {
+			return fmt.Errorf("duplicate epoch seed")
+		}
+		seeded[seed.EpochNumber] = struct{}{}
+	}
+	snapshotted := make(map[uint64]struct{})
+	for _, epochParams := range gs.EpochParams {
+		if epochParams.EpochNumber > gs.EpochNumber {
+			return fmt.Errorf("params of future epoch")
+		}
+		if epochParams.EpochNumber < gs.EarliestEpoch {
+			return fmt.Errorf("params of pruned epoch")
+		}
+		if err := epochParams.Params.Validate(); err != nil {
+			return err
+		}
+		if _, ok := snapshotted[epochParams.EpochNumber]; ok {
+			return fmt.Errorf("duplicate epoch params")
+		}
+		snapshotted[epochParams.EpochNumber] = struct{}{}
+	}
+	staked := make(map[uint64]map[string]struct{})
+	for _, stake := range gs.EpochStakes {
+		if stake.EpochNumber > gs.EpochNumber {
+			return fmt.Errorf("stake of future epoch")
+		}
+		if stake.EpochNumber < gs.EarliestEpoch {
+			return fmt.Errorf("stake of pruned epoch")
+		}
+		if err := ValidateHexAddress(stake.Account); err != nil {
+			return err
+		}
+		if stake.Bonded.IsNil() || stake.Bonded.IsNegative() {
+			return fmt.Errorf("epoch stake cannot be negative")
+		}
+		if _, ok := staked[stake.EpochNumber]; !ok {
+			staked[stake.EpochNumber] = make(map[string]struct{})
+		}
+		if _, ok := staked[stake.EpochNumber][stake.Account]; ok {
+			return fmt.Errorf("duplicate epoch stake")
+		}
+		staked[stake.EpochNumber][stake.Account] = struct{}{}
+	}
+	operated := make(map[string]struct{})
+	operators := make(map[string]struct{})
+	for _, signerOperator := range gs.SignerOperators {
+		if err := ValidateHexAddress(signerOperator.Account); err != nil {
+			return err
+		}
+		if err := ValidateHexAddress(signerOperator.Operator); err != nil {
+			return err
+		}
+		if strings.EqualFold(signerOperator.Account, signerOperator.Operator) {
+			return fmt.Errorf("signer cannot be its own operator")
+		}
+		if _, ok := operated[signerOperator.Account]; ok {
+			return fmt.Errorf("duplicate signer operator")
+		}
+		// an operator backs a single signer so its delegations are never counted twice
+		if _, ok := operators[signerOperator.Operator]; ok {
+			return fmt.Errorf("operator backs several signers")
+		}
+		if _, ok := registered[signerOperator.Operator]; ok {
+			return fmt.Errorf("operator is a registered signer")
+		}
+		operated[signerOperator.Account] = struct{}{}
+		operators[signerOperator.Operator] = struct{}{}
+	}
+	for account := range operated {
+		if _, ok := operators[account]; ok {
+			return fmt.Errorf("signer is an operator")
+		}
+	}
+	authorizing := make(map[string]struct{})
+	for _, pending := range gs.PendingAuthorizations {
+		if err := ValidateHexAddress(pending.Account); err != nil {
+			return err
+		}
+		if err := ValidateHexAddress(pending.Operator); err != nil {
+			return err
+		}
+		if strings.EqualFold(pending.Account, pending.Operator) {
+			return fmt.Errorf("signer cannot be its own operator")
+		}
+		if _, ok := authorizing[pending.Operator]; ok {
+			return fmt.Errorf("duplicate pending authorization")
+		}
+		authorizing[pending.Operator] = struct{}{}
+	}
 	return nil
 }
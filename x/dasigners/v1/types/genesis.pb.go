@@ -27,11 +27,26 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
-	TokensPerVote     uint64 `protobuf:"varint,1,opt,name=tokens_per_vote,json=tokensPerVote,proto3" json:"tokens_per_vote,omitempty"`
-	MaxVotesPerSigner uint64 `protobuf:"varint,2,opt,name=max_votes_per_signer,json=maxVotesPerSigner,proto3" json:"max_votes_per_signer,omitempty"`
-	MaxQuorums        uint64 `protobuf:"varint,3,opt,name=max_quorums,json=maxQuorums,proto3" json:"max_quorums,omitempty"`
-	EpochBlocks       uint64 `protobuf:"varint,4,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
-	EncodedSlices     uint64 `protobuf:"varint,5,opt,name=encoded_slices,json=encodedSlices,proto3" json:"encoded_slices,omitempty"`
+	TokensPerVote      uint64 `protobuf:"varint,1,opt,name=tokens_per_vote,json=tokensPerVote,proto3" json:"tokens_per_vote,omitempty"`
+	MaxVotesPerSigner  uint64 `protobuf:"varint,2,opt,name=max_votes_per_signer,json=maxVotesPerSigner,proto3" json:"max_votes_per_signer,omitempty"`
+	MaxQuorums         uint64 `protobuf:"varint,3,opt,name=max_quorums,json=maxQuorums,proto3" json:"max_quorums,omitempty"`
+	EpochBlocks        uint64 `protobuf:"varint,4,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	EncodedSlices      uint64 `protobuf:"varint,5,opt,name=encoded_slices,json=encodedSlices,proto3" json:"encoded_slices,omitempty"`
+	ExitCooldownEpochs uint64 `protobuf:"varint,6,opt,name=exit_cooldown_epochs,json=exitCooldownEpochs,proto3" json:"exit_cooldown_epochs,omitempty"`
+	// slash_fraction_bps defines the share of delegated stake burnt on misbehaviour, in basis points
+	SlashFractionBps uint64 `protobuf:"varint,7,opt,name=slash_fraction_bps,json=slashFractionBps,proto3" json:"slash_fraction_bps,omitempty"`
+	// history_retention_epochs defines how many past epochs of quorums and registrations are kept, zero keeps the full history
+	HistoryRetentionEpochs uint64 `protobuf:"varint,8,opt,name=history_retention_epochs,json=historyRetentionEpochs,proto3" json:"history_retention_epochs,omitempty"`
+	// reward_fee_share_bps defines the share of the collected fees moved to the signer reward pool, in basis points
+	RewardFeeShareBps uint64 `protobuf:"varint,9,opt,name=reward_fee_share_bps,json=rewardFeeShareBps,proto3" json:"reward_fee_share_bps,omitempty"`
+	// min_quorum_signers defines the minimum number of distinct signers in every quorum, zero disables the check
+	MinQuorumSigners uint64 `protobuf:"varint,10,opt,name=min_quorum_signers,json=minQuorumSigners,proto3" json:"min_quorum_signers,omitempty"`
+	// max_signer_share_bps defines the maximum share of the rows of a quorum held by one signer, in basis points,
+	// zero disables the check
+	MaxSignerShareBps uint64 `protobuf:"varint,11,opt,name=max_signer_share_bps,json=maxSignerShareBps,proto3" json:"max_signer_share_bps,omitempty"`
+	// gas_schedule defines the gas metered by the dasigners precompile and the signature checks on top of the basic gas
+	// of each method
+	GasSchedule GasSchedule `protobuf:"bytes,12,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,6 +117,167 @@ func (m *Params) GetEncodedSlices() uint64 {
 	return 0
 }
 
+func (m *Params) GetExitCooldownEpochs() uint64 {
+	if m != nil {
+		return m.ExitCooldownEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetSlashFractionBps() uint64 {
+	if m != nil {
+		return m.SlashFractionBps
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetentionEpochs() uint64 {
+	if m != nil {
+		return m.HistoryRetentionEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetRewardFeeShareBps() uint64 {
+	if m != nil {
+		return m.RewardFeeShareBps
+	}
+	return 0
+}
+
+func (m *Params) GetMinQuorumSigners() uint64 {
+	if m != nil {
+		return m.MinQuorumSigners
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSignerShareBps() uint64 {
+	if m != nil {
+		return m.MaxSignerShareBps
+	}
+	return 0
+}
+
+func (m *Params) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
+// GasSchedule defines the metered gas of the dasigners module, zero store costs are not charged
+type GasSchedule struct {
+	// has_cost, delete_cost and the following costs define the store gas of the precompile, as in the sdk KV gas config
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+	// pairing_cost defines the gas of one BN254 signature check made by the precompile
+	PairingCost uint64 `protobuf:"varint,8,opt,name=pairing_cost,json=pairingCost,proto3" json:"pairing_cost,omitempty"`
+	// aggregate_cost_per_signer defines the gas of adding the keys of one signer into an aggregated key
+	AggregateCostPerSigner uint64 `protobuf:"varint,9,opt,name=aggregate_cost_per_signer,json=aggregateCostPerSigner,proto3" json:"aggregate_cost_per_signer,omitempty"`
+}
+
+func (m *GasSchedule) Reset()         { *m = GasSchedule{} }
+func (m *GasSchedule) String() string { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()    {}
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_896efa766aaca3be, []int{1}
+}
+func (m *GasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSchedule.Merge(m, src)
+}
+func (m *GasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSchedule proto.InternalMessageInfo
+
+func (m *GasSchedule) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetPairingCost() uint64 {
+	if m != nil {
+		return m.PairingCost
+	}
+	return 0
+}
+
+func (m *GasSchedule) GetAggregateCostPerSigner() uint64 {
+	if m != nil {
+		return m.AggregateCostPerSigner
+	}
+	return 0
+}
+
 // GenesisState defines the dasigners module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to deposit.
@@ -112,13 +288,40 @@ type GenesisState struct {
 	Signers []*Signer `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
 	// quorums_by_epoch defines chosen quorums by epoch
 	QuorumsByEpoch []*Quorums `protobuf:"bytes,4,rep,name=quorums_by_epoch,json=quorumsByEpoch,proto3" json:"quorums_by_epoch,omitempty"`
+	// signer_exits defines the signers in exit cooldown
+	SignerExits []*SignerExit `protobuf:"bytes,5,rep,name=signer_exits,json=signerExits,proto3" json:"signer_exits,omitempty"`
+	// pending_params defines the params scheduled for the next epoch
+	PendingParams *Params `protobuf:"bytes,6,opt,name=pending_params,json=pendingParams,proto3" json:"pending_params,omitempty"`
+	// epoch_start_height defines the block height at which the current epoch started, if zero the epoch is assumed to
+	// start at epoch_number * epoch_blocks
+	EpochStartHeight uint64 `protobuf:"varint,7,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+	// signer_key_rotations defines the latest key rotation of each signer
+	SignerKeyRotations []*SignerKeyRotation `protobuf:"bytes,8,rep,name=signer_key_rotations,json=signerKeyRotations,proto3" json:"signer_key_rotations,omitempty"`
+	// previous_signer_keys defines the keys replaced by rotations, still used by quorums of earlier epochs
+	PreviousSignerKeys []*PreviousSignerKey `protobuf:"bytes,9,rep,name=previous_signer_keys,json=previousSignerKeys,proto3" json:"previous_signer_keys,omitempty"`
+	// epoch_seeds defines the quorum sampling seed of each generated epoch
+	EpochSeeds []*EpochSeed `protobuf:"bytes,10,rep,name=epoch_seeds,json=epochSeeds,proto3" json:"epoch_seeds,omitempty"`
+	// jailed_signers defines the signers permanently jailed for misbehaviour
+	JailedSigners []*JailedSigner `protobuf:"bytes,11,rep,name=jailed_signers,json=jailedSigners,proto3" json:"jailed_signers,omitempty"`
+	// earliest_epoch defines the epoch of the first entry of quorums_by_epoch, older epochs are pruned
+	EarliestEpoch uint64 `protobuf:"varint,12,opt,name=earliest_epoch,json=earliestEpoch,proto3" json:"earliest_epoch,omitempty"`
+	// signer_rewards defines the rewards allocated to signers and not claimed yet
+	SignerRewards []*SignerRewards `protobuf:"bytes,13,rep,name=signer_rewards,json=signerRewards,proto3" json:"signer_rewards,omitempty"`
+	// epoch_params defines the params in force when the quorums of each retained epoch were generated
+	EpochParams []*EpochParams `protobuf:"bytes,14,rep,name=epoch_params,json=epochParams,proto3" json:"epoch_params,omitempty"`
+	// signer_operators defines the signers authorized by an operator account to sign on its stake
+	SignerOperators []*SignerOperator `protobuf:"bytes,15,rep,name=signer_operators,json=signerOperators,proto3" json:"signer_operators,omitempty"`
+	// epoch_stakes defines the bonded tokens snapshots of the signers of each retained epoch
+	EpochStakes []*EpochStake `protobuf:"bytes,16,rep,name=epoch_stakes,json=epochStakes,proto3" json:"epoch_stakes,omitempty"`
+	// pending_authorizations defines the signers authorized by an operator account which did not accept it yet
+	PendingAuthorizations []*SignerOperator `protobuf:"bytes,17,rep,name=pending_authorizations,json=pendingAuthorizations,proto3" json:"pending_authorizations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_896efa766aaca3be, []int{1}
+	return fileDescriptor_896efa766aaca3be, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,43 +378,230 @@ func (m *GenesisState) GetQuorumsByEpoch() []*Quorums {
 	return nil
 }
 
+func (m *GenesisState) GetSignerExits() []*SignerExit {
+	if m != nil {
+		return m.SignerExits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingParams() *Params {
+	if m != nil {
+		return m.PendingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochStartHeight() uint64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSignerKeyRotations() []*SignerKeyRotation {
+	if m != nil {
+		return m.SignerKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPreviousSignerKeys() []*PreviousSignerKey {
+	if m != nil {
+		return m.PreviousSignerKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochSeeds() []*EpochSeed {
+	if m != nil {
+		return m.EpochSeeds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJailedSigners() []*JailedSigner {
+	if m != nil {
+		return m.JailedSigners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEarliestEpoch() uint64 {
+	if m != nil {
+		return m.EarliestEpoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSignerRewards() []*SignerRewards {
+	if m != nil {
+		return m.SignerRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochParams() []*EpochParams {
+	if m != nil {
+		return m.EpochParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSignerOperators() []*SignerOperator {
+	if m != nil {
+		return m.SignerOperators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochStakes() []*EpochStake {
+	if m != nil {
+		return m.EpochStakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAuthorizations() []*SignerOperator {
+	if m != nil {
+		return m.PendingAuthorizations
+	}
+	return nil
+}
+
+type EpochParams struct {
+	// epoch_number defines the epoch whose quorums were generated under the params
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// params defines the params in force for the epoch
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *EpochParams) Reset()         { *m = EpochParams{} }
+func (m *EpochParams) String() string { return proto.CompactTextString(m) }
+func (*EpochParams) ProtoMessage()    {}
+func (*EpochParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_896efa766aaca3be, []int{3}
+}
+func (m *EpochParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochParams.Merge(m, src)
+}
+func (m *EpochParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochParams proto.InternalMessageInfo
+
+func (m *EpochParams) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "zgc.dasigners.v1.Params")
+	proto.RegisterType((*GasSchedule)(nil), "zgc.dasigners.v1.GasSchedule")
 	proto.RegisterType((*GenesisState)(nil), "zgc.dasigners.v1.GenesisState")
+	proto.RegisterType((*EpochParams)(nil), "zgc.dasigners.v1.EpochParams")
 }
 
 func init() { proto.RegisterFile("zgc/dasigners/v1/genesis.proto", fileDescriptor_896efa766aaca3be) }
 
 var fileDescriptor_896efa766aaca3be = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x86, 0xb3, 0x24, 0x04, 0xc9, 0x29, 0xa5, 0x58, 0x3d, 0x6c, 0x7a, 0xd8, 0x84, 0x4a, 0x20,
-	0x2e, 0xac, 0xdb, 0x22, 0xf1, 0x00, 0x41, 0x08, 0x71, 0x41, 0x25, 0x91, 0x38, 0x70, 0x59, 0x79,
-	0x9d, 0xc1, 0x59, 0x35, 0xde, 0x59, 0xd6, 0xde, 0x28, 0xe9, 0x53, 0xf0, 0x58, 0x3d, 0x70, 0xe8,
-	0x91, 0x13, 0x42, 0xc9, 0x8b, 0xa0, 0x1d, 0x9b, 0x56, 0xb4, 0xdc, 0xec, 0xff, 0xff, 0x66, 0xf4,
-	0xcf, 0xd8, 0x2c, 0xb9, 0xd4, 0x4a, 0xcc, 0xa5, 0x2d, 0x74, 0x09, 0xb5, 0x15, 0xab, 0x53, 0xa1,
-	0xa1, 0x04, 0x5b, 0xd8, 0xb4, 0xaa, 0xd1, 0x21, 0x3f, 0xb8, 0xd4, 0x2a, 0xbd, 0xf1, 0xd3, 0xd5,
-	0xe9, 0xd1, 0x50, 0xa1, 0x35, 0x68, 0x33, 0xf2, 0x85, 0xbf, 0x78, 0xf8, 0xe8, 0x50, 0xa3, 0x46,
-	0xaf, 0xb7, 0xa7, 0xa0, 0x0e, 0x35, 0xa2, 0x5e, 0x82, 0xa0, 0x5b, 0xde, 0x7c, 0x15, 0xb2, 0xdc,
-	0x04, 0x6b, 0x74, 0xd7, 0x72, 0x85, 0x01, 0xeb, 0xa4, 0xa9, 0x02, 0x30, 0xbe, 0x17, 0xef, 0x36,
-	0x0b, 0x11, 0xc7, 0x3f, 0x22, 0xd6, 0x3f, 0x97, 0xb5, 0x34, 0x96, 0xbf, 0x60, 0x4f, 0x1c, 0x5e,
-	0x40, 0x69, 0xb3, 0x0a, 0xea, 0x6c, 0x85, 0x0e, 0xe2, 0x68, 0x1c, 0xbd, 0xec, 0x4d, 0x1f, 0x7b,
-	0xf9, 0x1c, 0xea, 0xcf, 0xe8, 0x80, 0x0b, 0x76, 0x68, 0xe4, 0x9a, 0x00, 0x8f, 0xfa, 0x8e, 0xf1,
-	0x03, 0x82, 0x9f, 0x1a, 0xb9, 0x6e, 0xb1, 0x16, 0x9f, 0x91, 0xc1, 0x47, 0x6c, 0xd0, 0x16, 0x7c,
-	0x6b, 0xb0, 0x6e, 0x8c, 0x8d, 0xbb, 0xc4, 0x31, 0x23, 0xd7, 0x9f, 0xbc, 0xc2, 0x9f, 0xb1, 0x3d,
-	0xa8, 0x50, 0x2d, 0xb2, 0x7c, 0x89, 0xea, 0xc2, 0xc6, 0x3d, 0x22, 0x06, 0xa4, 0x4d, 0x48, 0xe2,
-	0xcf, 0xd9, 0x3e, 0x94, 0x0a, 0xe7, 0x30, 0xcf, 0xec, 0xb2, 0x50, 0x60, 0xe3, 0x87, 0x3e, 0x5b,
-	0x50, 0x67, 0x24, 0x1e, 0x6f, 0x23, 0xb6, 0xf7, 0xde, 0xbf, 0xc0, 0xcc, 0x49, 0x07, 0xfc, 0x0d,
-	0xeb, 0x57, 0x34, 0x1e, 0xcd, 0x32, 0x38, 0x8b, 0xd3, 0xbb, 0x2f, 0x92, 0xfa, 0xf1, 0x27, 0xbd,
-	0xab, 0x5f, 0xa3, 0xce, 0x34, 0xd0, 0xb7, 0x91, 0xca, 0xc6, 0xe4, 0x37, 0xc3, 0xf9, 0x48, 0x1f,
-	0x49, 0xe2, 0x67, 0xec, 0x51, 0xe8, 0x12, 0x77, 0xc7, 0xdd, 0xff, 0xf7, 0xf6, 0x1b, 0x98, 0xfe,
-	0x05, 0xf9, 0x5b, 0x76, 0x10, 0xd6, 0x90, 0xe5, 0x9b, 0x8c, 0xba, 0xc5, 0x3d, 0x2a, 0x1e, 0xde,
-	0x2f, 0x0e, 0xeb, 0x99, 0xee, 0x87, 0x92, 0xc9, 0xe6, 0x1d, 0x6d, 0xe4, 0xc3, 0xd5, 0x36, 0x89,
-	0xae, 0xb7, 0x49, 0xf4, 0x7b, 0x9b, 0x44, 0xdf, 0x77, 0x49, 0xe7, 0x7a, 0x97, 0x74, 0x7e, 0xee,
-	0x92, 0xce, 0x17, 0xa1, 0x0b, 0xb7, 0x68, 0xf2, 0x54, 0xa1, 0x11, 0x27, 0x7a, 0x29, 0x73, 0x2b,
-	0x4e, 0xf4, 0x2b, 0xb5, 0x90, 0x45, 0x29, 0xd6, 0xff, 0x7e, 0x04, 0xb7, 0xa9, 0xc0, 0xe6, 0x7d,
-	0xfa, 0x05, 0xaf, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xff, 0xe5, 0x90, 0xe2, 0xc8, 0x02, 0x00,
-	0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x17, 0x8d, 0x9b, 0xfc, 0xd2, 0xfe, 0x66, 0x1d, 0xc7, 0x9d, 0x86, 0x6a, 0x53, 0xc0, 0x71, 0xc3,
+	0x1f, 0x55, 0xa2, 0xf5, 0xb6, 0x45, 0x42, 0x20, 0x21, 0x15, 0x5c, 0x25, 0x05, 0x2a, 0x95, 0xb0,
+	0x16, 0x20, 0xf1, 0xb2, 0x1a, 0xaf, 0x6f, 0x76, 0xb7, 0xd9, 0xdd, 0x59, 0xe6, 0x8e, 0x53, 0xbb,
+	0x4f, 0x7c, 0x04, 0x3e, 0x11, 0xcf, 0x7d, 0xec, 0x23, 0x2f, 0x20, 0x94, 0x7c, 0x11, 0x34, 0x33,
+	0xd7, 0x6b, 0x27, 0xae, 0x11, 0xbc, 0xed, 0xdc, 0x73, 0xe6, 0xcc, 0x9d, 0xb9, 0xe7, 0x5e, 0x2d,
+	0xeb, 0xbc, 0x4c, 0xe2, 0x60, 0x24, 0x30, 0x4b, 0x4a, 0x50, 0x18, 0x9c, 0x3e, 0x08, 0x12, 0x28,
+	0x01, 0x33, 0xec, 0x55, 0x4a, 0x6a, 0xc9, 0xdb, 0x2f, 0x93, 0xb8, 0x57, 0xe3, 0xbd, 0xd3, 0x07,
+	0xb7, 0x76, 0x63, 0x89, 0x85, 0xc4, 0xc8, 0xe2, 0x81, 0x5b, 0x38, 0xf2, 0xad, 0x9d, 0x44, 0x26,
+	0xd2, 0xc5, 0xcd, 0x17, 0x45, 0x77, 0x13, 0x29, 0x93, 0x1c, 0x02, 0xbb, 0x1a, 0x8e, 0x8f, 0x03,
+	0x51, 0x4e, 0x09, 0xda, 0xbb, 0x0c, 0xe9, 0xac, 0x00, 0xd4, 0xa2, 0xa8, 0x88, 0xd0, 0x5d, 0x4a,
+	0x6f, 0x9e, 0x8b, 0x65, 0xec, 0xff, 0xb6, 0xc1, 0x36, 0x8f, 0x84, 0x12, 0x05, 0xf2, 0x0f, 0xd9,
+	0xb6, 0x96, 0x27, 0x50, 0x62, 0x54, 0x81, 0x8a, 0x4e, 0xa5, 0x06, 0xbf, 0xd1, 0x6d, 0xdc, 0xd9,
+	0x08, 0xb7, 0x5c, 0xf8, 0x08, 0xd4, 0x0f, 0x52, 0x03, 0x0f, 0xd8, 0x4e, 0x21, 0x26, 0x96, 0xe0,
+	0xa8, 0x4e, 0xd1, 0xbf, 0x62, 0xc9, 0xd7, 0x0b, 0x31, 0x31, 0x34, 0x43, 0x1f, 0x58, 0x80, 0xef,
+	0x31, 0xcf, 0x6c, 0xf8, 0x79, 0x2c, 0xd5, 0xb8, 0x40, 0x7f, 0xdd, 0xf2, 0x58, 0x21, 0x26, 0xdf,
+	0xb9, 0x08, 0xbf, 0xcd, 0x9a, 0x50, 0xc9, 0x38, 0x8d, 0x86, 0xb9, 0x8c, 0x4f, 0xd0, 0xdf, 0xb0,
+	0x0c, 0xcf, 0xc6, 0xfa, 0x36, 0xc4, 0x3f, 0x60, 0x2d, 0x28, 0x63, 0x39, 0x82, 0x51, 0x84, 0x79,
+	0x16, 0x03, 0xfa, 0xff, 0x73, 0xb9, 0x51, 0x74, 0x60, 0x83, 0xfc, 0x3e, 0xdb, 0x81, 0x49, 0xa6,
+	0xa3, 0x58, 0xca, 0x7c, 0x24, 0x5f, 0x94, 0x91, 0xd5, 0x40, 0x7f, 0xd3, 0x92, 0xb9, 0xc1, 0x1e,
+	0x13, 0x74, 0x60, 0x11, 0x7e, 0x97, 0x71, 0xcc, 0x05, 0xa6, 0xd1, 0xb1, 0x12, 0xb1, 0xce, 0x64,
+	0x19, 0x0d, 0x2b, 0xf4, 0xaf, 0x5a, 0x7e, 0xdb, 0x22, 0x87, 0x04, 0xf4, 0x2b, 0xe4, 0x9f, 0x32,
+	0x3f, 0xcd, 0x50, 0x4b, 0x35, 0x8d, 0x14, 0x68, 0x28, 0xed, 0x06, 0x3a, 0xe3, 0x9a, 0xdd, 0x73,
+	0x93, 0xf0, 0x70, 0x06, 0xd3, 0x39, 0x01, 0xdb, 0x51, 0xf0, 0x42, 0xa8, 0x51, 0x74, 0x0c, 0x10,
+	0x61, 0x2a, 0x14, 0xd8, 0x93, 0xfe, 0xef, 0x5e, 0xcd, 0x61, 0x87, 0x00, 0x03, 0x83, 0x98, 0xa3,
+	0xee, 0x32, 0x5e, 0x64, 0x25, 0xbd, 0x1a, 0xbd, 0x31, 0xfa, 0xcc, 0x25, 0x56, 0x64, 0xa5, 0x7b,
+	0x3c, 0xf7, 0xc4, 0x38, 0x2b, 0x8a, 0xa3, 0x2d, 0xc8, 0x7b, 0x75, 0x51, 0x1c, 0xb3, 0x96, 0x3f,
+	0x64, 0xcd, 0x44, 0x60, 0x84, 0x71, 0x0a, 0xa3, 0x71, 0x0e, 0x7e, 0xb3, 0xdb, 0xb8, 0xe3, 0x3d,
+	0x7c, 0xb7, 0x77, 0xd9, 0xb0, 0xbd, 0x27, 0x02, 0x07, 0x44, 0xea, 0x6f, 0xbc, 0xfa, 0x73, 0x6f,
+	0x2d, 0xf4, 0x92, 0x79, 0x68, 0xff, 0x97, 0x75, 0xe6, 0x2d, 0x50, 0xf8, 0x2e, 0xbb, 0x96, 0x0a,
+	0x8c, 0x62, 0x89, 0x9a, 0xec, 0x73, 0x35, 0x15, 0xf8, 0x58, 0xa2, 0x36, 0x3e, 0x18, 0x41, 0x0e,
+	0x1a, 0x1c, 0xea, 0xfc, 0xc2, 0x5c, 0xc8, 0x12, 0xde, 0x67, 0x2d, 0x05, 0x62, 0x64, 0xe1, 0xe8,
+	0x38, 0x17, 0x9a, 0xbc, 0xd2, 0x34, 0x51, 0xc3, 0x38, 0xcc, 0x85, 0xe6, 0x1f, 0x31, 0x3e, 0x67,
+	0x19, 0xff, 0x0d, 0xa7, 0x1a, 0xc8, 0x33, 0xdb, 0x33, 0xe6, 0x11, 0xa8, 0xfe, 0x54, 0x83, 0x31,
+	0xf5, 0x0b, 0x95, 0x69, 0x58, 0xd0, 0x24, 0xe3, 0xd8, 0x70, 0x2d, 0x7a, 0x8f, 0xdd, 0x58, 0xe0,
+	0xd5, 0xaa, 0xce, 0x37, 0xed, 0x9a, 0x3b, 0x93, 0xbd, 0xc7, 0x6e, 0x64, 0x1a, 0x54, 0x54, 0xc2,
+	0x44, 0x2f, 0x48, 0x93, 0x6d, 0x0c, 0xf4, 0x0c, 0x26, 0xba, 0x56, 0xbf, 0xcd, 0x9a, 0x95, 0xc8,
+	0x54, 0x56, 0x26, 0xee, 0xea, 0xce, 0x2a, 0x1e, 0xc5, 0xec, 0xdd, 0x3f, 0x63, 0xbb, 0x22, 0x49,
+	0x14, 0x24, 0x62, 0x31, 0x09, 0x6a, 0x2d, 0x67, 0x92, 0x9b, 0x35, 0x81, 0x52, 0x71, 0x25, 0xdd,
+	0xff, 0xe3, 0x1a, 0x6b, 0x3e, 0x71, 0x63, 0x67, 0xa0, 0x85, 0x06, 0xfe, 0x09, 0xdb, 0xac, 0x6c,
+	0x4f, 0xdb, 0x0a, 0x78, 0x0f, 0xfd, 0xe5, 0xaa, 0xba, 0x9e, 0xa7, 0x82, 0x12, 0x7b, 0xde, 0x87,
+	0xe5, 0xb8, 0x18, 0xd6, 0x1d, 0xed, 0xfa, 0xf0, 0x99, 0x0d, 0xf1, 0x87, 0xec, 0xea, 0xcc, 0x8a,
+	0xeb, 0xdd, 0xf5, 0x37, 0x6b, 0xbb, 0xb4, 0xc2, 0x19, 0x91, 0x3f, 0x66, 0x6d, 0xea, 0xfd, 0x68,
+	0x38, 0x75, 0xdd, 0xe2, 0x6f, 0xd8, 0xcd, 0xbb, 0xcb, 0x9b, 0x69, 0x26, 0x84, 0x2d, 0xda, 0xd2,
+	0x9f, 0xda, 0x06, 0xe2, 0x8f, 0x58, 0x93, 0xcc, 0x6d, 0x9a, 0xd8, 0xb4, 0xbf, 0x11, 0x78, 0x67,
+	0xd5, 0xe9, 0x07, 0x93, 0x4c, 0x87, 0x1e, 0xd6, 0xdf, 0xc8, 0x1f, 0xb1, 0x56, 0x05, 0xe5, 0xc8,
+	0xd4, 0x80, 0x1e, 0x67, 0xf3, 0x9f, 0x1f, 0x27, 0xdc, 0x22, 0xbe, 0x5b, 0x9a, 0x86, 0x74, 0xaf,
+	0x83, 0x5a, 0x28, 0x1d, 0xa5, 0x90, 0x25, 0x69, 0x5d, 0x72, 0x8b, 0x0c, 0x0c, 0xf0, 0x95, 0x8d,
+	0xf3, 0xef, 0xd9, 0x0e, 0xe5, 0x7b, 0x02, 0xd3, 0x48, 0x49, 0x2d, 0xcc, 0x30, 0x30, 0x53, 0xc2,
+	0xe4, 0xfd, 0xde, 0xaa, 0xbc, 0x9f, 0xc2, 0x34, 0x24, 0x6e, 0xc8, 0xf1, 0x72, 0x08, 0x8d, 0x6c,
+	0xa5, 0xe0, 0x34, 0x93, 0x63, 0x8c, 0xe6, 0xfa, 0x66, 0x8c, 0xac, 0x90, 0x3d, 0x22, 0xf6, 0x5c,
+	0x9e, 0x57, 0x97, 0x43, 0xc8, 0x3f, 0x67, 0x1e, 0xdd, 0x0d, 0x60, 0x64, 0xa6, 0x8c, 0x51, 0x7b,
+	0x7b, 0x59, 0xcd, 0xd6, 0x62, 0x00, 0x30, 0x0a, 0x19, 0xcc, 0x3e, 0x91, 0x1f, 0xb0, 0xd6, 0x73,
+	0x91, 0xe5, 0x66, 0x36, 0x93, 0x37, 0x3c, 0x2b, 0xd0, 0x59, 0x16, 0xf8, 0xc6, 0xf2, 0xc8, 0x21,
+	0x5b, 0xcf, 0x17, 0x56, 0x6e, 0xc6, 0x0b, 0x95, 0x67, 0x80, 0x9a, 0x5c, 0xd2, 0xa4, 0x19, 0x4f,
+	0x51, 0xe7, 0x84, 0x43, 0xd6, 0xa2, 0x9b, 0xbb, 0xa1, 0x89, 0xfe, 0x96, 0x3d, 0x6d, 0x6f, 0xa5,
+	0x13, 0x1d, 0x2d, 0xdc, 0xc2, 0xc5, 0x25, 0xff, 0x62, 0xe6, 0x76, 0xb2, 0x43, 0xab, 0xbb, 0xfe,
+	0xe6, 0x09, 0x68, 0x8f, 0x25, 0x4f, 0x78, 0x30, 0x5f, 0xf0, 0xa7, 0xac, 0x4d, 0x99, 0xc8, 0x0a,
+	0x94, 0xd0, 0x52, 0xa1, 0xbf, 0x6d, 0x55, 0xba, 0xab, 0x72, 0xf9, 0x96, 0x88, 0xe1, 0x36, 0x5e,
+	0x58, 0x1b, 0x7f, 0x36, 0x6b, 0x7b, 0x9d, 0x00, 0xfa, 0xed, 0x55, 0x06, 0x3f, 0x20, 0xab, 0x9d,
+	0x00, 0x65, 0x63, 0xbf, 0x91, 0xff, 0xc8, 0x6e, 0xce, 0x0c, 0x2e, 0xc6, 0x3a, 0x95, 0x2a, 0x7b,
+	0x49, 0x9e, 0xbb, 0xfe, 0x2f, 0x73, 0x7a, 0x8b, 0xf6, 0x7f, 0x79, 0x61, 0xfb, 0x7e, 0xca, 0xbc,
+	0x85, 0x27, 0x58, 0x9a, 0x12, 0x8d, 0xe5, 0x29, 0x31, 0x1f, 0x40, 0x57, 0xfe, 0xcb, 0x00, 0xea,
+	0x7f, 0xfd, 0xea, 0xac, 0xd3, 0x78, 0x7d, 0xd6, 0x69, 0xfc, 0x75, 0xd6, 0x69, 0xfc, 0x7a, 0xde,
+	0x59, 0x7b, 0x7d, 0xde, 0x59, 0xfb, 0xfd, 0xbc, 0xb3, 0xf6, 0x53, 0x90, 0x64, 0x3a, 0x1d, 0x0f,
+	0x7b, 0xb1, 0x2c, 0x82, 0xfb, 0x49, 0x2e, 0x86, 0x18, 0xdc, 0x4f, 0xee, 0xc5, 0xa9, 0xc8, 0xca,
+	0x60, 0x72, 0xf1, 0x17, 0x47, 0x4f, 0x2b, 0xc0, 0xe1, 0xa6, 0xfd, 0xbf, 0xf9, 0xf8, 0xef, 0x01,
+	0x00, 0xa9, 0x68, 0xb1, 0x7b, 0xa2, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -234,6 +624,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxSignerShareBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSignerShareBps))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinQuorumSigners != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinQuorumSigners))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RewardFeeShareBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RewardFeeShareBps))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HistoryRetentionEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryRetentionEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashFractionBps != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SlashFractionBps))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExitCooldownEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExitCooldownEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EncodedSlices != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.EncodedSlices))
 		i--
@@ -262,7 +692,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *GasSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -272,51 +702,285 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuorumsByEpoch) > 0 {
-		for iNdEx := len(m.QuorumsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.QuorumsByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if m.AggregateCostPerSigner != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AggregateCostPerSigner))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Signers) > 0 {
-		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.PairingCost != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PairingCost))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IterNextCostFlat))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x38
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingAuthorizations) > 0 {
+		for iNdEx := len(m.PendingAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAuthorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.EpochStakes) > 0 {
+		for iNdEx := len(m.EpochStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SignerOperators) > 0 {
+		for iNdEx := len(m.SignerOperators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerOperators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EpochParams) > 0 {
+		for iNdEx := len(m.EpochParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.SignerRewards) > 0 {
+		for iNdEx := len(m.SignerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.EarliestEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarliestEpoch))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.JailedSigners) > 0 {
+		for iNdEx := len(m.JailedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailedSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.EpochSeeds) > 0 {
+		for iNdEx := len(m.EpochSeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochSeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PreviousSignerKeys) > 0 {
+		for iNdEx := len(m.PreviousSignerKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousSignerKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SignerKeyRotations) > 0 {
+		for iNdEx := len(m.SignerKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PendingParams != nil {
+		{
+			size, err := m.PendingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignerExits) > 0 {
+		for iNdEx := len(m.SignerExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QuorumsByEpoch) > 0 {
+		for iNdEx := len(m.QuorumsByEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuorumsByEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -328,6 +992,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -360,6 +1062,62 @@ func (m *Params) Size() (n int) {
 	if m.EncodedSlices != 0 {
 		n += 1 + sovGenesis(uint64(m.EncodedSlices))
 	}
+	if m.ExitCooldownEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.ExitCooldownEpochs))
+	}
+	if m.SlashFractionBps != 0 {
+		n += 1 + sovGenesis(uint64(m.SlashFractionBps))
+	}
+	if m.HistoryRetentionEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryRetentionEpochs))
+	}
+	if m.RewardFeeShareBps != 0 {
+		n += 1 + sovGenesis(uint64(m.RewardFeeShareBps))
+	}
+	if m.MinQuorumSigners != 0 {
+		n += 1 + sovGenesis(uint64(m.MinQuorumSigners))
+	}
+	if m.MaxSignerShareBps != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSignerShareBps))
+	}
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovGenesis(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovGenesis(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovGenesis(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovGenesis(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovGenesis(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovGenesis(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovGenesis(uint64(m.IterNextCostFlat))
+	}
+	if m.PairingCost != 0 {
+		n += 1 + sovGenesis(uint64(m.PairingCost))
+	}
+	if m.AggregateCostPerSigner != 0 {
+		n += 1 + sovGenesis(uint64(m.AggregateCostPerSigner))
+	}
 	return n
 }
 
@@ -386,18 +1144,102 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+	if len(m.SignerExits) > 0 {
+		for _, e := range m.SignerExits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PendingParams != nil {
+		l = m.PendingParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochStartHeight))
+	}
+	if len(m.SignerKeyRotations) > 0 {
+		for _, e := range m.SignerKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PreviousSignerKeys) > 0 {
+		for _, e := range m.PreviousSignerKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochSeeds) > 0 {
+		for _, e := range m.EpochSeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.JailedSigners) > 0 {
+		for _, e := range m.JailedSigners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EarliestEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.EarliestEpoch))
+	}
+	if len(m.SignerRewards) > 0 {
+		for _, e := range m.SignerRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochParams) > 0 {
+		for _, e := range m.EpochParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SignerOperators) > 0 {
+		for _, e := range m.SignerOperators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochStakes) > 0 {
+		for _, e := range m.EpochStakes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAuthorizations) > 0 {
+		for _, e := range m.PendingAuthorizations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -405,30 +1247,820 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensPerVote", wireType)
+			}
+			m.TokensPerVote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokensPerVote |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotesPerSigner", wireType)
+			}
+			m.MaxVotesPerSigner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVotesPerSigner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuorums", wireType)
+			}
+			m.MaxQuorums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQuorums |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedSlices", wireType)
+			}
+			m.EncodedSlices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncodedSlices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCooldownEpochs", wireType)
+			}
+			m.ExitCooldownEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitCooldownEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBps", wireType)
+			}
+			m.SlashFractionBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashFractionBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionEpochs", wireType)
+			}
+			m.HistoryRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFeeShareBps", wireType)
+			}
+			m.RewardFeeShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardFeeShareBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinQuorumSigners", wireType)
+			}
+			m.MinQuorumSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinQuorumSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSignerShareBps", wireType)
+			}
+			m.MaxSignerShareBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSignerShareBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairingCost", wireType)
+			}
+			m.PairingCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairingCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateCostPerSigner", wireType)
+			}
+			m.AggregateCostPerSigner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregateCostPerSigner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &Signer{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumsByEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuorumsByEpoch = append(m.QuorumsByEpoch, &Quorums{})
+			if err := m.QuorumsByEpoch[len(m.QuorumsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerExits = append(m.SignerExits, &SignerExit{})
+			if err := m.SignerExits[len(m.SignerExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingParams == nil {
+				m.PendingParams = &Params{}
+			}
+			if err := m.PendingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerKeyRotations = append(m.SignerKeyRotations, &SignerKeyRotation{})
+			if err := m.SignerKeyRotations[len(m.SignerKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSignerKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PreviousSignerKeys = append(m.PreviousSignerKeys, &PreviousSignerKey{})
+			if err := m.PreviousSignerKeys[len(m.PreviousSignerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensPerVote", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochSeeds", wireType)
 			}
-			m.TokensPerVote = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -438,16 +2070,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokensPerVote |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotesPerSigner", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.MaxVotesPerSigner = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochSeeds = append(m.EpochSeeds, &EpochSeed{})
+			if err := m.EpochSeeds[len(m.EpochSeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedSigners", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -457,16 +2104,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxVotesPerSigner |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailedSigners = append(m.JailedSigners, &JailedSigner{})
+			if err := m.JailedSigners[len(m.JailedSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuorums", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestEpoch", wireType)
 			}
-			m.MaxQuorums = 0
+			m.EarliestEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -476,16 +2138,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxQuorums |= uint64(b&0x7F) << shift
+				m.EarliestEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerRewards", wireType)
 			}
-			m.EpochBlocks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -495,16 +2157,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBlocks |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncodedSlices", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.EncodedSlices = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerRewards = append(m.SignerRewards, &SignerRewards{})
+			if err := m.SignerRewards[len(m.SignerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochParams", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -514,64 +2191,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EncodedSlices |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EpochParams = append(m.EpochParams, &EpochParams{})
+			if err := m.EpochParams[len(m.EpochParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerOperators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -598,15 +2240,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignerOperators = append(m.SignerOperators, &SignerOperator{})
+			if err := m.SignerOperators[len(m.SignerOperators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStakes", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -616,14 +2259,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStakes = append(m.EpochStakes, &EpochStake{})
+			if err := m.EpochStakes[len(m.EpochStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAuthorizations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -650,14 +2308,83 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, &Signer{})
-			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingAuthorizations = append(m.PendingAuthorizations, &SignerOperator{})
+			if err := m.PendingAuthorizations[len(m.PendingAuthorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuorumsByEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -684,8 +2411,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuorumsByEpoch = append(m.QuorumsByEpoch, &Quorums{})
-			if err := m.QuorumsByEpoch[len(m.QuorumsByEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -27,6 +27,23 @@ func PubkeyRegistrationHash(operatorAddress common.Address, chainId *big.Int) *b
 	return bn254util.MapToCurve(msgHash32)
 }
 
+func PubkeyRotationHash(operatorAddress common.Address, chainId *big.Int, nonce uint64) *bn254.G1Affine {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, operatorAddress.Bytes()...)
+	// make sure chainId is 32 bytes
+	toHash = append(toHash, common.LeftPadBytes(chainId.Bytes(), 32)...)
+	toHash = append(toHash, []byte("0G_BN254_Pubkey_Rotation")...)
+	toHash = append(toHash, sdk.Uint64ToBigEndian(nonce)...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+
+	// hash to G1
+	return bn254util.MapToCurve(msgHash32)
+}
+
 func EpochRegistrationHash(operatorAddress common.Address, epoch uint64, chainId *big.Int) *bn254.G1Affine {
 	toHash := make([]byte, 0)
 	toHash = append(toHash, operatorAddress.Bytes()...)
@@ -41,3 +58,22 @@ func EpochRegistrationHash(operatorAddress common.Address, epoch uint64, chainId
 	// hash to G1
 	return bn254util.MapToCurve(msgHash32)
 }
+
+// DABlobHash returns the hash a DA signer signs for a blob, the abi packed data root, epoch, quorum id and erasure
+// commitment as hashed by the DA entrance contract, which checks the aggregate signatures of the quorums on it
+func DABlobHash(dataRoot []byte, epoch uint64, quorumId uint64, erasureCommitment []byte) *bn254.G1Affine {
+	toHash := make([]byte, 0)
+	toHash = append(toHash, dataRoot...)
+	// epoch and quorum id are uint256
+	toHash = append(toHash, common.LeftPadBytes(sdk.Uint64ToBigEndian(epoch), 32)...)
+	toHash = append(toHash, common.LeftPadBytes(sdk.Uint64ToBigEndian(quorumId), 32)...)
+	toHash = append(toHash, erasureCommitment...)
+
+	msgHash := crypto.Keccak256(toHash)
+	// convert to [32]byte
+	var msgHash32 [32]byte
+	copy(msgHash32[:], msgHash)
+
+	// hash to G1
+	return bn254util.MapToCurve(msgHash32)
+}
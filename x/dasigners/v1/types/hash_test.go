@@ -4,8 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0glabs/0g-chain/crypto/bn254util"
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +22,10 @@ func Test_EpochRegistrationHash(t *testing.T) {
 	assert.Equal(t, hash.X.String(), "13283083124528531674735853832182424672122091139683454761857829308708073730285")
 	assert.Equal(t, hash.Y.String(), "21773064143788270772276852950775943855438706734263253481317981346601766662828")
 }
+
+func Test_DABlobHash(t *testing.T) {
+	commitment := bn254util.SerializeG1(bn254util.GetG1Generator())
+	hash := types.DABlobHash(crypto.Keccak256([]byte{0}), 1, 7, commitment)
+	assert.Equal(t, hash.X.String(), "15331490175266028862509645783865713800355807749764519865367516616829099374171")
+	assert.Equal(t, hash.Y.String(), "8803138449145799344277861230063528068602921277495155696821821773422578301584")
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiDASignersHooks combines multiple dasigners hooks, all hook functions are run in array sequence
+type MultiDASignersHooks []DASignersHooks
+
+var _ DASignersHooks = MultiDASignersHooks{}
+
+func NewMultiDASignersHooks(hooks ...DASignersHooks) MultiDASignersHooks {
+	return hooks
+}
+
+func (h MultiDASignersHooks) AfterSignerRegistered(ctx sdk.Context, signer Signer) error {
+	for i := range h {
+		if err := h[i].AfterSignerRegistered(ctx, signer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiDASignersHooks) AfterEpochGenerated(ctx sdk.Context, epoch uint64, quorums Quorums) error {
+	for i := range h {
+		if err := h[i].AfterEpochGenerated(ctx, epoch, quorums); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiDASignersHooks) AfterSignerRemoved(ctx sdk.Context, account string) error {
+	for i := range h {
+		if err := h[i].AfterSignerRemoved(ctx, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,14 +19,32 @@ const (
 
 var (
 	// prefix
-	SignerKeyPrefix       = []byte{0x00}
-	EpochQuorumsKeyPrefix = []byte{0x01}
-	RegistrationKeyPrefix = []byte{0x02}
-	QuorumCountKeyPrefix  = []byte{0x03}
+	SignerKeyPrefix           = []byte{0x00}
+	EpochQuorumsKeyPrefix     = []byte{0x01}
+	RegistrationKeyPrefix     = []byte{0x02}
+	QuorumCountKeyPrefix      = []byte{0x03}
+	SignerExitKeyPrefix       = []byte{0x07}
+	EpochStartHeightKeyPrefix = []byte{0x09}
+	SignerStakeKeyPrefix      = []byte{0x0a}
+	EpochStakeKeyPrefix       = []byte{0x0b}
+	SignerKeyRotationPrefix   = []byte{0x0c}
+	PreviousSignerKeyPrefix   = []byte{0x0d}
+	EpochSeedKeyPrefix        = []byte{0x0e}
+	JailedSignerKeyPrefix     = []byte{0x0f}
+	SignerRewardsKeyPrefix    = []byte{0x11}
+	EpochParamsKeyPrefix      = []byte{0x13}
+	SignerOperatorKeyPrefix   = []byte{0x14}
+	OperatorSignerKeyPrefix   = []byte{0x15}
+	// PendingAuthorizationKeyPrefix tracks the signer authorized by each operator and not accepted by the signer yet
+	PendingAuthorizationKeyPrefix = []byte{0x16}
 
 	// keys
-	ParamsKey      = []byte{0x05}
-	EpochNumberKey = []byte{0x06}
+	ParamsKey        = []byte{0x05}
+	EpochNumberKey   = []byte{0x06}
+	PendingParamsKey = []byte{0x08}
+	EarliestEpochKey = []byte{0x10}
+	// OutstandingRewardsKey tracks the rewards allocated to signers and not claimed yet
+	OutstandingRewardsKey = []byte{0x12}
 )
 
 func GetSignerKeyFromAccount(account string) ([]byte, error) {
@@ -39,10 +57,34 @@ func GetEpochQuorumKey(epoch uint64, quorumId uint64) []byte {
 	return b
 }
 
+func GetEpochQuorumsKeyPrefix(epoch uint64) []byte {
+	return append(EpochQuorumsKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
 func GetQuorumCountKey(epoch uint64) []byte {
 	return sdk.Uint64ToBigEndian(epoch)
 }
 
+func GetEpochStartHeightKey(epoch uint64) []byte {
+	return sdk.Uint64ToBigEndian(epoch)
+}
+
+func GetEpochSeedKey(epoch uint64) []byte {
+	return sdk.Uint64ToBigEndian(epoch)
+}
+
+func GetEpochParamsKey(epoch uint64) []byte {
+	return sdk.Uint64ToBigEndian(epoch)
+}
+
+func GetJailedSignerKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerRewardsKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
 func GetEpochRegistrationKeyPrefix(epoch uint64) []byte {
 	return append(RegistrationKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
 }
@@ -50,3 +92,47 @@ func GetEpochRegistrationKeyPrefix(epoch uint64) []byte {
 func GetRegistrationKey(account string) ([]byte, error) {
 	return hex.DecodeString(account)
 }
+
+func GetSignerExitKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerStakeKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetEpochStakeKeyPrefix(epoch uint64) []byte {
+	return append(EpochStakeKeyPrefix, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func GetEpochStakeKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetSignerKeyRotationKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetPreviousSignerKeyPrefix(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetPreviousSignerKeyKey(account string, lastEpoch uint64) ([]byte, error) {
+	b, err := hex.DecodeString(account)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, sdk.Uint64ToBigEndian(lastEpoch)...), nil
+}
+
+func GetSignerOperatorKey(account string) ([]byte, error) {
+	return hex.DecodeString(account)
+}
+
+func GetOperatorSignerKey(operator string) ([]byte, error) {
+	return hex.DecodeString(operator)
+}
+
+func GetPendingAuthorizationKey(operator string) ([]byte, error) {
+	return hex.DecodeString(operator)
+}
@@ -1,15 +1,18 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	fmt "fmt"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/0glabs/0g-chain/crypto/bn254util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}
+var _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgRegisterSigner{}, &MsgUpdateSocket{}, &MsgRegisterNextEpoch{}, &MsgDeregisterSigner{}, &MsgUpdateParams{}, &MsgRotateSignerKey{}, &MsgSubmitDAEvidence{}, &MsgClaimRewards{}, &MsgAuthorizeSigner{}
 
 // GetSigners returns the expected signers for a MsgRegister message.
 func (msg *MsgRegisterSigner) GetSigners() []sdk.AccAddress {
@@ -32,6 +35,14 @@ func (msg *MsgRegisterSigner) ValidateBasic() error {
 	if len(msg.Signature) != bn254util.G1PointSize {
 		return fmt.Errorf("invalid signature")
 	}
+	if msg.Operator != "" {
+		if err := ValidateHexAddress(msg.Operator); err != nil {
+			return err
+		}
+		if strings.EqualFold(msg.Operator, msg.Signer.Account) {
+			return fmt.Errorf("signer cannot be its own operator")
+		}
+	}
 	return nil
 }
 
@@ -94,3 +105,185 @@ func (msg *MsgRegisterNextEpoch) ValidateBasic() error {
 func (msg MsgRegisterNextEpoch) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
 }
+
+// GetSigners returns the expected signers for a MsgDeregisterSigner message.
+func (msg *MsgDeregisterSigner) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgDeregisterSigner) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeregisterSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotateSignerKey message.
+func (msg *MsgRotateSignerKey) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgRotateSignerKey) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if len(msg.PubkeyG1) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid G1 pubkey length")
+	}
+	if len(msg.PubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 pubkey length")
+	}
+	if len(msg.Signature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRotateSignerKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitDAEvidence message.
+func (msg *MsgSubmitDAEvidence) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Submitter)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgSubmitDAEvidence) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Submitter); err != nil {
+		return err
+	}
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	if msg.First == nil || msg.Second == nil {
+		return fmt.Errorf("missing signed commitment")
+	}
+	if len(msg.DataRoot) != 32 {
+		return fmt.Errorf("invalid data root")
+	}
+	if len(msg.First.Commitment) != bn254util.G1PointSize || len(msg.Second.Commitment) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid erasure commitment")
+	}
+	if len(msg.First.Signature) != bn254util.G1PointSize || len(msg.Second.Signature) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid signature")
+	}
+	if bytes.Equal(msg.First.Commitment, msg.Second.Commitment) {
+		return fmt.Errorf("commitments are not conflicting")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSubmitDAEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClaimRewards message.
+func (msg *MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Account)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgClaimRewards) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Account); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeSigner message.
+func (msg *MsgAuthorizeSigner) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromHex(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+	accAddr, err := sdk.AccAddressFromHexUnsafe(hex.EncodeToString(valAddr.Bytes()))
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (msg *MsgAuthorizeSigner) ValidateBasic() error {
+	if err := ValidateHexAddress(msg.Operator); err != nil {
+		return err
+	}
+	if err := ValidateHexAddress(msg.Signer); err != nil {
+		return err
+	}
+	if strings.EqualFold(msg.Operator, msg.Signer) {
+		return fmt.Errorf("signer cannot be its own operator")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAuthorizeSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
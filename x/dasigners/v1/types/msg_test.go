@@ -9,6 +9,7 @@ import (
 	"github.com/0glabs/0g-chain/x/dasigners/v1/types"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -58,6 +59,115 @@ func (suite *MsgTestSuite) Test_MsgRegisterNextEpoch() {
 	suite.Assert().NoError(msg.ValidateBasic())
 }
 
+func (suite *MsgTestSuite) Test_MsgDeregisterSigner() {
+	msg := &types.MsgDeregisterSigner{
+		Account: "9685C4EB29309820CDC62663CC6CC82F3D42E964",
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.Account = "0x9685C4EB29309820CDC62663CC6CC82F3D42E964"
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) Test_MsgUpdateParams() {
+	msg := &types.MsgUpdateParams{
+		Authority: "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna",
+		Params:    types.DefaultGenesisState().Params,
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.Params.EncodedSlices = 0
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Params = types.DefaultGenesisState().Params
+	msg.Params.GasSchedule.PairingCost = 0
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Params = types.DefaultGenesisState().Params
+	msg.Params.GasSchedule.AggregateCostPerSigner = 0
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Params = types.DefaultGenesisState().Params
+	msg.Authority = "9685C4EB29309820CDC62663CC6CC82F3D42E964"
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) Test_MsgRotateSignerKey() {
+	sk := big.NewInt(2)
+	pkG1 := new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), sk)
+	pkG2 := new(bn254.G2Affine).ScalarMultiplication(bn254util.GetG2Generator(), sk)
+	hash := types.PubkeyRotationHash(common.HexToAddress("0x9685C4EB29309820CDC62663CC6CC82F3D42E964"), big.NewInt(8888), 1)
+	signature := new(bn254.G1Affine).ScalarMultiplication(hash, sk)
+	msg := &types.MsgRotateSignerKey{
+		Account:   "9685C4EB29309820CDC62663CC6CC82F3D42E964",
+		PubkeyG1:  bn254util.SerializeG1(pkG1),
+		PubkeyG2:  bn254util.SerializeG2(pkG2),
+		Signature: bn254util.SerializeG1(signature),
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.PubkeyG2 = msg.PubkeyG2[:64]
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) Test_MsgSubmitDAEvidence() {
+	dataRoot := crypto.Keccak256([]byte("blob"))
+	sign := func(sk int64) *types.SignedCommitment {
+		commitment := bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(bn254util.GetG1Generator(), big.NewInt(sk)))
+		hash := types.DABlobHash(dataRoot, 1, 0, commitment)
+		return &types.SignedCommitment{
+			Commitment: commitment,
+			Signature:  bn254util.SerializeG1(new(bn254.G1Affine).ScalarMultiplication(hash, big.NewInt(1))),
+		}
+	}
+	msg := &types.MsgSubmitDAEvidence{
+		Submitter: "9685C4EB29309820CDC62663CC6CC82F3D42E964",
+		Account:   "0000000000000000000000000000000000000001",
+		Epoch:     1,
+		QuorumId:  0,
+		First:     sign(1),
+		Second:    sign(2),
+		DataRoot:  dataRoot,
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.DataRoot = dataRoot[:31]
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.DataRoot = dataRoot
+	msg.Second.Commitment = msg.Second.Commitment[:32]
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Second = sign(1)
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Second = nil
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) Test_MsgClaimRewards() {
+	msg := &types.MsgClaimRewards{
+		Account: "9685C4EB29309820CDC62663CC6CC82F3D42E964",
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.Account = "0x9685C4EB29309820CDC62663CC6CC82F3D42E964"
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
+func (suite *MsgTestSuite) Test_MsgAuthorizeSigner() {
+	msg := &types.MsgAuthorizeSigner{
+		Operator: "9685C4EB29309820CDC62663CC6CC82F3D42E964",
+		Signer:   "0000000000000000000000000000000000000001",
+	}
+	suite.Assert().EqualValues(len(msg.GetSigners()), 1)
+	suite.Assert().EqualValues(msg.GetSigners()[0].String(), "0g1j6zuf6efxzvzpnwxye3ucmxg9u7596ty686hna")
+	suite.Assert().NoError(msg.ValidateBasic())
+	msg.Signer = "9685c4eb29309820cdc62663cc6cc82f3d42e964"
+	suite.Assert().Error(msg.ValidateBasic())
+	msg.Signer = "0x0000000000000000000000000000000000000001"
+	suite.Assert().Error(msg.ValidateBasic())
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -0,0 +1,78 @@
+package types
+
+import (
+	fmt "fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// MaxBasisPoints is the basis points value of a fraction of one.
+const MaxBasisPoints = 10000
+
+// Validate performs basic validation of dasigners params.
+func (p Params) Validate() error {
+	if p.TokensPerVote == 0 {
+		return fmt.Errorf("tokens per vote must be positive")
+	}
+	if p.MaxVotesPerSigner == 0 {
+		return fmt.Errorf("max votes per signer must be positive")
+	}
+	if p.MaxQuorums == 0 {
+		return fmt.Errorf("max quorums must be positive")
+	}
+	if p.EpochBlocks == 0 {
+		return fmt.Errorf("epoch blocks must be positive")
+	}
+	if p.EncodedSlices == 0 {
+		return fmt.Errorf("encoded slices must be positive")
+	}
+	if p.SlashFractionBps > MaxBasisPoints {
+		return fmt.Errorf("slash fraction cannot exceed %d basis points", MaxBasisPoints)
+	}
+	if p.RewardFeeShareBps > MaxBasisPoints {
+		return fmt.Errorf("reward fee share cannot exceed %d basis points", MaxBasisPoints)
+	}
+	if p.MinQuorumSigners > p.EncodedSlices {
+		return fmt.Errorf("min quorum signers cannot exceed encoded slices")
+	}
+	if p.MaxSignerShareBps > MaxBasisPoints {
+		return fmt.Errorf("max signer share cannot exceed %d basis points", MaxBasisPoints)
+	}
+	if p.GasSchedule.PairingCost == 0 {
+		return fmt.Errorf("pairing cost must be positive")
+	}
+	if p.GasSchedule.AggregateCostPerSigner == 0 {
+		return fmt.Errorf("aggregate cost per signer must be positive")
+	}
+	return nil
+}
+
+// DefaultGasSchedule returns the store costs of the sdk KV gas config, the pairing cost of a two pairs check in the
+// EIP-1108 schedule and the per signer cost of deserializing and adding a G1 and a G2 key.
+func DefaultGasSchedule() GasSchedule {
+	kvGasConfig := storetypes.KVGasConfig()
+	return GasSchedule{
+		HasCost:                kvGasConfig.HasCost,
+		DeleteCost:             kvGasConfig.DeleteCost,
+		ReadCostFlat:           kvGasConfig.ReadCostFlat,
+		ReadCostPerByte:        kvGasConfig.ReadCostPerByte,
+		WriteCostFlat:          kvGasConfig.WriteCostFlat,
+		WriteCostPerByte:       kvGasConfig.WriteCostPerByte,
+		IterNextCostFlat:       kvGasConfig.IterNextCostFlat,
+		PairingCost:            113000,
+		AggregateCostPerSigner: 3000,
+	}
+}
+
+// KVGasConfig returns the store gas config of the gas schedule.
+func (s GasSchedule) KVGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          s.HasCost,
+		DeleteCost:       s.DeleteCost,
+		ReadCostFlat:     s.ReadCostFlat,
+		ReadCostPerByte:  s.ReadCostPerByte,
+		WriteCostFlat:    s.WriteCostFlat,
+		WriteCostPerByte: s.WriteCostPerByte,
+		IterNextCostFlat: s.IterNextCostFlat,
+	}
+}
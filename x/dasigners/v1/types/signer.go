@@ -53,3 +53,29 @@ func (s *Signer) ValidateSignature(hash *bn254.G1Affine, signature *bn254.G1Affi
 	}
 	return ok
 }
+
+func (r *SignerKeyRotation) Validate() error {
+	if err := ValidateHexAddress(r.Account); err != nil {
+		return err
+	}
+	if len(r.PubkeyG1) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid G1 pubkey length")
+	}
+	if len(r.PubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 pubkey length")
+	}
+	return nil
+}
+
+func (k *PreviousSignerKey) Validate() error {
+	if err := ValidateHexAddress(k.Account); err != nil {
+		return err
+	}
+	if len(k.PubkeyG1) != bn254util.G1PointSize {
+		return fmt.Errorf("invalid G1 pubkey length")
+	}
+	if len(k.PubkeyG2) != bn254util.G2PointSize {
+		return fmt.Errorf("invalid G2 pubkey length")
+	}
+	return nil
+}